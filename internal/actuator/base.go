@@ -3,6 +3,7 @@ package actuator
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 	"time"
 
@@ -63,16 +64,24 @@ func NewActuatorClient(ctx context.Context, transportFactory k8s.TransportFactor
 		return nil, err
 	}
 
-	restyClient := resty.New().
-		SetTransport(transport).
-		SetScheme("http").
-		SetBaseURL("http://port-forwarded-actuator/" + basePath).
+	restyClient := newRestyClient(transport, basePath).
 		SetTimeout(defaultHTTPTimeout)
 
-	httpClient := newRestyHTTPClient(restyClient)
+	// Streamed downloads (e.g. heap dumps) can take far longer than regular requests,
+	// so they are only bounded by the caller's context
+	streamingClient := newRestyClient(transport, basePath)
+
+	httpClient := newRestyHTTPClient(restyClient, streamingClient)
 	return &actuatorClient{httpClient: httpClient}, nil
 }
 
+func newRestyClient(transport http.RoundTripper, basePath string) *resty.Client {
+	return resty.New().
+		SetTransport(transport).
+		SetScheme("http").
+		SetBaseURL("http://port-forwarded-actuator/" + basePath)
+}
+
 func endpointError(endpoint string, status string, messagePrefix string) error {
 	return fmt.Errorf("%s: %s\nMake sure the '%s' endpoint is exposed in your Spring Boot configuration: https://docs.spring.io/spring-boot/reference/actuator/endpoints.html", messagePrefix, status, endpoint)
 }
@@ -39,6 +39,14 @@ func (m *mockK8sClient) GetDeploymentPods(_ context.Context, _, _ string) ([]str
 	return nil, nil
 }
 
+func (m *mockK8sClient) GetPodDeployment(_ context.Context, _, _ string) (string, error) {
+	return "", nil
+}
+
+func (m *mockK8sClient) WaitForReplacementPod(_ context.Context, _, _ string, _ *corev1.Pod, _ []string) (string, error) {
+	return "", nil
+}
+
 func (m *mockK8sClient) Clientset() kubernetes.Interface {
 	return fake.NewClientset()
 }
@@ -0,0 +1,64 @@
+package actuator
+
+import (
+	"fmt"
+	"net/url"
+)
+
+func (c *actuatorClient) GetCaches() (*CachesResponse, error) {
+	var cachesResponse CachesResponse
+	if err := c.getAndParse("/caches", "caches", "failed to get caches", &cachesResponse); err != nil {
+		return nil, err
+	}
+	return &cachesResponse, nil
+}
+
+func (c *actuatorClient) EvictCache(cacheName string, cacheManager string) error {
+	path := "/caches/" + url.PathEscape(cacheName)
+	if cacheManager != "" {
+		path += "?" + url.Values{"cacheManager": []string{cacheManager}}.Encode()
+	}
+
+	resp, err := c.httpClient.Delete(path)
+	if err != nil {
+		return err
+	}
+
+	if resp.IsErrorStatus() {
+		switch {
+		case resp.StatusCode == 404 && c.isEndpointAccessible("/caches"):
+			return resourceNotFoundError("cache", cacheName, resp.Status)
+		case resp.StatusCode == 400:
+			// Spring returns 400 when the cache name exists in more than one cache manager
+			return fmt.Errorf("cache '%s' is not unique, a cache manager must be specified: %s", cacheName, resp.Status)
+		}
+		return endpointError("caches", resp.Status, "failed to evict cache")
+	}
+
+	return nil
+}
+
+func (c *actuatorClient) EvictAllCaches() error {
+	resp, err := c.httpClient.Delete("/caches")
+	if err != nil {
+		return err
+	}
+
+	if resp.IsErrorStatus() {
+		return endpointError("caches", resp.Status, "failed to evict caches")
+	}
+
+	return nil
+}
+
+type CachesResponse struct {
+	CacheManagers map[string]CacheManager `json:"cacheManagers"`
+}
+
+type CacheManager struct {
+	Caches map[string]Cache `json:"caches"`
+}
+
+type Cache struct {
+	Target string `json:"target"`
+}
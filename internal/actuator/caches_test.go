@@ -0,0 +1,262 @@
+package actuator
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestActuatorClientGetCaches(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockResponse     string
+		mockStatus       int
+		mockErr          error
+		wantErr          bool
+		wantManagersCnt  int
+		wantCachesInMgr  map[string]int
+		wantCacheTargets map[string]string
+	}{
+		{
+			name: "successful response with caches",
+			mockResponse: `{
+				"cacheManagers": {
+					"cacheManager": {
+						"caches": {
+							"countries": {"target": "java.util.concurrent.ConcurrentHashMap"},
+							"cities": {"target": "java.util.concurrent.ConcurrentHashMap"}
+						}
+					}
+				}
+			}`,
+			mockStatus:      200,
+			wantErr:         false,
+			wantManagersCnt: 1,
+			wantCachesInMgr: map[string]int{"cacheManager": 2},
+			wantCacheTargets: map[string]string{
+				"countries": "java.util.concurrent.ConcurrentHashMap",
+			},
+		},
+		{
+			name: "multiple cache managers",
+			mockResponse: `{
+				"cacheManagers": {
+					"cacheManager": {
+						"caches": {"countries": {"target": "java.util.concurrent.ConcurrentHashMap"}}
+					},
+					"anotherCacheManager": {
+						"caches": {"countries": {"target": "com.github.benmanes.caffeine.cache.BoundedLocalCache"}}
+					}
+				}
+			}`,
+			mockStatus:      200,
+			wantErr:         false,
+			wantManagersCnt: 2,
+			wantCachesInMgr: map[string]int{"cacheManager": 1, "anotherCacheManager": 1},
+		},
+		{
+			name:            "no cache managers",
+			mockResponse:    `{"cacheManagers": {}}`,
+			mockStatus:      200,
+			wantErr:         false,
+			wantManagersCnt: 0,
+		},
+		{
+			name:         "404 endpoint not found",
+			mockResponse: ``,
+			mockStatus:   404,
+			wantErr:      true,
+		},
+		{
+			name:         "malformed JSON",
+			mockResponse: `{"cacheManagers": invalid}`,
+			mockStatus:   200,
+			wantErr:      true,
+		},
+		{
+			name:    "network error",
+			mockErr: errors.New("connection refused"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				GetFunc: func(path string) (*Response, error) {
+					if path != "/caches" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.GetCaches()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetCaches() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if len(result.CacheManagers) != tt.wantManagersCnt {
+				t.Errorf("got %d cache managers, want %d", len(result.CacheManagers), tt.wantManagersCnt)
+			}
+			for manager, want := range tt.wantCachesInMgr {
+				if got := len(result.CacheManagers[manager].Caches); got != want {
+					t.Errorf("cache manager %s has %d caches, want %d", manager, got, want)
+				}
+			}
+			for cacheName, want := range tt.wantCacheTargets {
+				if got := result.CacheManagers["cacheManager"].Caches[cacheName].Target; got != want {
+					t.Errorf("cache %s target = %s, want %s", cacheName, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestActuatorClientEvictCache(t *testing.T) {
+	tests := []struct {
+		name         string
+		cacheName    string
+		cacheManager string
+		mockStatus   int
+		indexStatus  int
+		wantErr      bool
+		errContains  string
+		wantPath     string
+	}{
+		{
+			name:       "successful eviction",
+			cacheName:  "countries",
+			mockStatus: 204,
+			wantErr:    false,
+			wantPath:   "/caches/countries",
+		},
+		{
+			name:         "eviction with cache manager",
+			cacheName:    "countries",
+			cacheManager: "anotherCacheManager",
+			mockStatus:   204,
+			wantErr:      false,
+			wantPath:     "/caches/countries?cacheManager=anotherCacheManager",
+		},
+		{
+			name:       "cache name is escaped",
+			cacheName:  "my cache/1",
+			mockStatus: 204,
+			wantErr:    false,
+			wantPath:   "/caches/my%20cache%2F1",
+		},
+		{
+			name:        "cache not found",
+			cacheName:   "missing",
+			mockStatus:  404,
+			indexStatus: 200,
+			wantErr:     true,
+			errContains: "cache 'missing' not found",
+		},
+		{
+			name:        "caches endpoint not exposed",
+			cacheName:   "countries",
+			mockStatus:  404,
+			indexStatus: 404,
+			wantErr:     true,
+			errContains: "endpoint is exposed",
+		},
+		{
+			name:        "cache name not unique",
+			cacheName:   "countries",
+			mockStatus:  400,
+			wantErr:     true,
+			errContains: "not unique",
+		},
+		{
+			name:        "500 internal server error",
+			cacheName:   "countries",
+			mockStatus:  500,
+			wantErr:     true,
+			errContains: "failed to evict cache",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var capturedPath string
+			mockClient := &MockHTTPClient{
+				GetFunc: func(path string) (*Response, error) {
+					return &Response{StatusCode: tt.indexStatus, Status: strconv.Itoa(tt.indexStatus)}, nil
+				},
+				DeleteFunc: func(path string) (*Response, error) {
+					capturedPath = path
+					return &Response{StatusCode: tt.mockStatus, Status: strconv.Itoa(tt.mockStatus)}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			err := client.EvictCache(tt.cacheName, tt.cacheManager)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EvictCache() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("expected error containing '%s', got '%v'", tt.errContains, err)
+			}
+
+			if tt.wantPath != "" && capturedPath != tt.wantPath {
+				t.Errorf("DELETE path = %v, want %v", capturedPath, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestActuatorClientEvictAllCaches(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockStatus int
+		mockErr    error
+		wantErr    bool
+	}{
+		{"successful eviction", 204, nil, false},
+		{"endpoint not found", 404, nil, true},
+		{"network error", 0, errors.New("connection refused"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DeleteFunc: func(path string) (*Response, error) {
+					if path != "/caches" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &Response{StatusCode: tt.mockStatus, Status: strconv.Itoa(tt.mockStatus)}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			err := client.EvictAllCaches()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EvictAllCaches() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
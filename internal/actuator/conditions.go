@@ -0,0 +1,31 @@
+package actuator
+
+func (c *actuatorClient) GetConditions() (*ConditionsResponse, error) {
+	var conditionsResponse ConditionsResponse
+	if err := c.getAndParse("/conditions", "conditions", "failed to get conditions", &conditionsResponse); err != nil {
+		return nil, err
+	}
+	return &conditionsResponse, nil
+}
+
+type ConditionsResponse struct {
+	Contexts map[string]ConditionsContext `json:"contexts"`
+}
+
+type ConditionsContext struct {
+	PositiveMatches      map[string][]ConditionOutcome `json:"positiveMatches"`
+	NegativeMatches      map[string]NegativeMatch      `json:"negativeMatches"`
+	Exclusions           []string                      `json:"exclusions"`
+	UnconditionalClasses []string                      `json:"unconditionalClasses"`
+	ParentID             string                        `json:"parentId,omitempty"`
+}
+
+type ConditionOutcome struct {
+	Condition string `json:"condition"`
+	Message   string `json:"message"`
+}
+
+type NegativeMatch struct {
+	NotMatched []ConditionOutcome `json:"notMatched"`
+	Matched    []ConditionOutcome `json:"matched"`
+}
@@ -0,0 +1,144 @@
+package actuator
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestActuatorClientGetConditions(t *testing.T) {
+	tests := []struct {
+		name            string
+		mockResponse    string
+		mockStatus      int
+		wantErr         bool
+		wantContextsCnt int
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"contexts": {
+					"application": {
+						"positiveMatches": {},
+						"negativeMatches": {},
+						"exclusions": [],
+						"unconditionalClasses": []
+					}
+				}
+			}`,
+			mockStatus:      200,
+			wantErr:         false,
+			wantContextsCnt: 1,
+		},
+		{
+			name:         "404 endpoint not found",
+			mockResponse: ``,
+			mockStatus:   404,
+			wantErr:      true,
+		},
+		{
+			name:         "malformed JSON",
+			mockResponse: `{"contexts": invalid}`,
+			mockStatus:   200,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				GetFunc: func(path string) (*Response, error) {
+					if path != "/conditions" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.GetConditions()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetConditions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && len(result.Contexts) != tt.wantContextsCnt {
+				t.Errorf("got %d contexts, want %d", len(result.Contexts), tt.wantContextsCnt)
+			}
+		})
+	}
+}
+
+func TestConditionsResponseParsing(t *testing.T) {
+	response := `{
+		"contexts": {
+			"application": {
+				"positiveMatches": {
+					"EndpointAutoConfiguration#endpointOperationParameterMapper": [
+						{"condition": "OnBeanCondition", "message": "@ConditionalOnMissingBean did not find any beans"}
+					]
+				},
+				"negativeMatches": {
+					"WebFluxEndpointManagementContextConfiguration": {
+						"notMatched": [
+							{"condition": "OnWebApplicationCondition", "message": "not a reactive web application"}
+						],
+						"matched": [
+							{"condition": "OnClassCondition", "message": "@ConditionalOnClass found required classes"}
+						]
+					}
+				},
+				"exclusions": ["org.example.ExcludedAutoConfiguration"],
+				"unconditionalClasses": ["org.springframework.boot.autoconfigure.context.ConfigurationPropertiesAutoConfiguration"],
+				"parentId": "bootstrap"
+			}
+		}
+	}`
+
+	mockClient := &MockHTTPClient{
+		GetFunc: func(path string) (*Response, error) {
+			return &Response{Body: []byte(response), StatusCode: 200, Status: "200"}, nil
+		},
+	}
+
+	client := &actuatorClient{httpClient: mockClient}
+	result, err := client.GetConditions()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	appCtx, ok := result.Contexts["application"]
+	if !ok {
+		t.Fatal("expected application context")
+	}
+
+	positive := appCtx.PositiveMatches["EndpointAutoConfiguration#endpointOperationParameterMapper"]
+	if len(positive) != 1 || positive[0].Condition != "OnBeanCondition" {
+		t.Errorf("unexpected positive match: %+v", positive)
+	}
+
+	negative, ok := appCtx.NegativeMatches["WebFluxEndpointManagementContextConfiguration"]
+	if !ok {
+		t.Fatal("expected negative match for WebFluxEndpointManagementContextConfiguration")
+	}
+	if len(negative.NotMatched) != 1 || negative.NotMatched[0].Message != "not a reactive web application" {
+		t.Errorf("unexpected notMatched: %+v", negative.NotMatched)
+	}
+	if len(negative.Matched) != 1 || negative.Matched[0].Condition != "OnClassCondition" {
+		t.Errorf("unexpected matched: %+v", negative.Matched)
+	}
+
+	if len(appCtx.Exclusions) != 1 {
+		t.Errorf("expected 1 exclusion, got %d", len(appCtx.Exclusions))
+	}
+	if len(appCtx.UnconditionalClasses) != 1 {
+		t.Errorf("expected 1 unconditional class, got %d", len(appCtx.UnconditionalClasses))
+	}
+	if appCtx.ParentID != "bootstrap" {
+		t.Errorf("expected parentId 'bootstrap', got '%s'", appCtx.ParentID)
+	}
+}
@@ -0,0 +1,26 @@
+package actuator
+
+func (c *actuatorClient) GetConfigProps() (*ConfigPropsResponse, error) {
+	var configPropsResponse ConfigPropsResponse
+	if err := c.getAndParse("/configprops", "configprops", "failed to get configuration properties", &configPropsResponse); err != nil {
+		return nil, err
+	}
+	return &configPropsResponse, nil
+}
+
+type ConfigPropsResponse struct {
+	Contexts map[string]ConfigPropsContext `json:"contexts"`
+}
+
+type ConfigPropsContext struct {
+	Beans    map[string]ConfigPropsBean `json:"beans"`
+	ParentID string                     `json:"parentId,omitempty"`
+}
+
+// ConfigPropsBean describes a @ConfigurationProperties bean. Properties holds the bound values,
+// Inputs mirrors its structure with the raw input value and origin of every leaf property.
+type ConfigPropsBean struct {
+	Prefix     string                 `json:"prefix"`
+	Properties map[string]interface{} `json:"properties"`
+	Inputs     map[string]interface{} `json:"inputs"`
+}
@@ -0,0 +1,138 @@
+package actuator
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestActuatorClientGetConfigProps(t *testing.T) {
+	tests := []struct {
+		name            string
+		mockResponse    string
+		mockStatus      int
+		wantErr         bool
+		wantContextsCnt int
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"contexts": {
+					"application": {
+						"beans": {}
+					}
+				}
+			}`,
+			mockStatus:      200,
+			wantErr:         false,
+			wantContextsCnt: 1,
+		},
+		{
+			name:         "404 endpoint not found",
+			mockResponse: ``,
+			mockStatus:   404,
+			wantErr:      true,
+		},
+		{
+			name:         "malformed JSON",
+			mockResponse: `{"contexts": invalid}`,
+			mockStatus:   200,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				GetFunc: func(path string) (*Response, error) {
+					if path != "/configprops" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.GetConfigProps()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetConfigProps() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && len(result.Contexts) != tt.wantContextsCnt {
+				t.Errorf("got %d contexts, want %d", len(result.Contexts), tt.wantContextsCnt)
+			}
+		})
+	}
+}
+
+func TestConfigPropsResponseParsing(t *testing.T) {
+	response := `{
+		"contexts": {
+			"application": {
+				"beans": {
+					"spring.cache-org.springframework.boot.autoconfigure.cache.CacheProperties": {
+						"prefix": "spring.cache",
+						"properties": {
+							"cacheNames": ["testCache"],
+							"redis": {"cacheNullValues": true}
+						},
+						"inputs": {
+							"cacheNames": [
+								{"value": "testCache", "origin": "class path resource [application.yml] - 5:18"}
+							],
+							"redis": {"cacheNullValues": {}}
+						}
+					}
+				},
+				"parentId": "bootstrap"
+			}
+		}
+	}`
+
+	mockClient := &MockHTTPClient{
+		GetFunc: func(path string) (*Response, error) {
+			return &Response{Body: []byte(response), StatusCode: 200, Status: "200"}, nil
+		},
+	}
+
+	client := &actuatorClient{httpClient: mockClient}
+	result, err := client.GetConfigProps()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	appCtx, ok := result.Contexts["application"]
+	if !ok {
+		t.Fatal("expected application context")
+	}
+	if appCtx.ParentID != "bootstrap" {
+		t.Errorf("expected parentId 'bootstrap', got '%s'", appCtx.ParentID)
+	}
+
+	bean, ok := appCtx.Beans["spring.cache-org.springframework.boot.autoconfigure.cache.CacheProperties"]
+	if !ok {
+		t.Fatal("expected CacheProperties bean")
+	}
+	if bean.Prefix != "spring.cache" {
+		t.Errorf("expected prefix 'spring.cache', got '%s'", bean.Prefix)
+	}
+
+	cacheNames, ok := bean.Properties["cacheNames"].([]interface{})
+	if !ok || len(cacheNames) != 1 || cacheNames[0] != "testCache" {
+		t.Errorf("unexpected cacheNames property: %v", bean.Properties["cacheNames"])
+	}
+
+	inputs, ok := bean.Inputs["cacheNames"].([]interface{})
+	if !ok || len(inputs) != 1 {
+		t.Fatalf("unexpected cacheNames inputs: %v", bean.Inputs["cacheNames"])
+	}
+	input, ok := inputs[0].(map[string]interface{})
+	if !ok || input["origin"] != "class path resource [application.yml] - 5:18" {
+		t.Errorf("unexpected cacheNames input: %v", inputs[0])
+	}
+}
@@ -0,0 +1,35 @@
+package actuator
+
+func (c *actuatorClient) GetFlyway() (*FlywayResponse, error) {
+	var response FlywayResponse
+	if err := c.getAndParse("/flyway", "flyway", "failed to get flyway migrations", &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+type FlywayResponse struct {
+	Contexts map[string]FlywayContext `json:"contexts"`
+}
+
+type FlywayContext struct {
+	FlywayBeans map[string]FlywayBean `json:"flywayBeans"`
+	ParentID    string                `json:"parentId,omitempty"`
+}
+
+type FlywayBean struct {
+	Migrations []FlywayMigration `json:"migrations"`
+}
+
+type FlywayMigration struct {
+	Type          string `json:"type"`
+	Checksum      *int64 `json:"checksum,omitempty"`
+	Version       string `json:"version,omitempty"`
+	Description   string `json:"description"`
+	Script        string `json:"script"`
+	State         string `json:"state"`
+	InstalledBy   string `json:"installedBy,omitempty"`
+	InstalledOn   string `json:"installedOn,omitempty"`
+	InstalledRank *int   `json:"installedRank,omitempty"`
+	ExecutionTime *int64 `json:"executionTime,omitempty"`
+}
@@ -0,0 +1,144 @@
+package actuator
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestActuatorClientGetFlyway(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockResponse string
+		mockStatus   int
+		wantErr      bool
+		wantBeansCnt int
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"contexts": {
+					"application": {
+						"flywayBeans": {
+							"flyway": {"migrations": []}
+						}
+					}
+				}
+			}`,
+			mockStatus:   200,
+			wantErr:      false,
+			wantBeansCnt: 1,
+		},
+		{
+			name:         "404 endpoint not found",
+			mockResponse: ``,
+			mockStatus:   404,
+			wantErr:      true,
+		},
+		{
+			name:         "malformed JSON",
+			mockResponse: `{"contexts": invalid}`,
+			mockStatus:   200,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				GetFunc: func(path string) (*Response, error) {
+					if path != "/flyway" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.GetFlyway()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetFlyway() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && len(result.Contexts["application"].FlywayBeans) != tt.wantBeansCnt {
+				t.Errorf("got %d flyway beans, want %d", len(result.Contexts["application"].FlywayBeans), tt.wantBeansCnt)
+			}
+		})
+	}
+}
+
+func TestFlywayResponseParsing(t *testing.T) {
+	response := `{
+		"contexts": {
+			"application": {
+				"flywayBeans": {
+					"flyway": {
+						"migrations": [
+							{
+								"type": "SQL",
+								"checksum": -1206125431,
+								"version": "1",
+								"description": "create orders",
+								"script": "V1__create_orders.sql",
+								"state": "SUCCESS",
+								"installedBy": "SA",
+								"installedOn": "2024-01-15T10:30:00.000Z",
+								"installedRank": 1,
+								"executionTime": 12
+							},
+							{
+								"type": "SQL",
+								"checksum": 42,
+								"version": "2",
+								"description": "add index",
+								"script": "V2__add_index.sql",
+								"state": "PENDING"
+							}
+						]
+					}
+				}
+			}
+		}
+	}`
+
+	mockClient := &MockHTTPClient{
+		GetFunc: func(path string) (*Response, error) {
+			return &Response{Body: []byte(response), StatusCode: 200, Status: "200"}, nil
+		},
+	}
+
+	client := &actuatorClient{httpClient: mockClient}
+	result, err := client.GetFlyway()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	migrations := result.Contexts["application"].FlywayBeans["flyway"].Migrations
+	if len(migrations) != 2 {
+		t.Fatalf("expected 2 migrations, got %d", len(migrations))
+	}
+
+	applied := migrations[0]
+	if applied.Checksum == nil || *applied.Checksum != -1206125431 {
+		t.Errorf("unexpected checksum: %v", applied.Checksum)
+	}
+	if applied.InstalledRank == nil || *applied.InstalledRank != 1 {
+		t.Errorf("unexpected installed rank: %v", applied.InstalledRank)
+	}
+	if applied.ExecutionTime == nil || *applied.ExecutionTime != 12 {
+		t.Errorf("unexpected execution time: %v", applied.ExecutionTime)
+	}
+	if applied.State != "SUCCESS" || applied.InstalledOn != "2024-01-15T10:30:00.000Z" {
+		t.Errorf("unexpected migration: %+v", applied)
+	}
+
+	pending := migrations[1]
+	if pending.State != "PENDING" || pending.InstalledRank != nil || pending.InstalledOn != "" {
+		t.Errorf("unexpected pending migration: %+v", pending)
+	}
+}
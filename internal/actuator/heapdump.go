@@ -0,0 +1,17 @@
+package actuator
+
+import "context"
+
+func (c *actuatorClient) GetHeapDump(ctx context.Context) (*StreamResponse, error) {
+	resp, err := c.httpClient.GetStream(ctx, "/heapdump")
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.IsErrorStatus() {
+		_ = resp.Body.Close()
+		return nil, endpointError("heapdump", resp.Status, "failed to get heap dump")
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,133 @@
+package actuator
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type trackingReadCloser struct {
+	io.Reader
+	closed bool
+}
+
+func (t *trackingReadCloser) Close() error {
+	t.closed = true
+	return nil
+}
+
+func TestActuatorClientGetHeapDump(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockBody      string
+		mockStatus    int
+		mockErr       error
+		wantErr       bool
+		errContains   string
+		wantBody      string
+		wantBodyClose bool
+	}{
+		{
+			name:       "successful stream",
+			mockBody:   "JAVA PROFILE 1.0.2",
+			mockStatus: 200,
+			wantErr:    false,
+			wantBody:   "JAVA PROFILE 1.0.2",
+		},
+		{
+			name:          "endpoint not found closes body",
+			mockStatus:    404,
+			wantErr:       true,
+			errContains:   "failed to get heap dump",
+			wantBodyClose: true,
+		},
+		{
+			name:          "server error closes body",
+			mockStatus:    503,
+			wantErr:       true,
+			errContains:   "heapdump",
+			wantBodyClose: true,
+		},
+		{
+			name:    "network error",
+			mockErr: errors.New("connection refused"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := &trackingReadCloser{Reader: strings.NewReader(tt.mockBody)}
+			mockClient := &MockHTTPClient{
+				GetStreamFunc: func(_ context.Context, path string) (*StreamResponse, error) {
+					if path != "/heapdump" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					if tt.mockErr != nil {
+						return nil, tt.mockErr
+					}
+					return &StreamResponse{
+						Body:          body,
+						ContentLength: int64(len(tt.mockBody)),
+						StatusCode:    tt.mockStatus,
+						Status:        strconv.Itoa(tt.mockStatus),
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.GetHeapDump(context.Background())
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetHeapDump() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				if tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("expected error containing '%s', got '%v'", tt.errContains, err)
+				}
+				if tt.wantBodyClose && !body.closed {
+					t.Error("expected response body to be closed on error")
+				}
+				return
+			}
+
+			defer func() { _ = result.Body.Close() }()
+			data, err := io.ReadAll(result.Body)
+			if err != nil {
+				t.Fatalf("unexpected error reading body: %v", err)
+			}
+			if string(data) != tt.wantBody {
+				t.Errorf("body = %q, want %q", string(data), tt.wantBody)
+			}
+			if result.ContentLength != int64(len(tt.wantBody)) {
+				t.Errorf("content length = %d, want %d", result.ContentLength, len(tt.wantBody))
+			}
+		})
+	}
+}
+
+func TestStreamResponseIsErrorStatus(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		wantError  bool
+	}{
+		{200, false},
+		{206, false},
+		{404, true},
+		{500, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.statusCode), func(t *testing.T) {
+			resp := &StreamResponse{StatusCode: tt.statusCode}
+			if got := resp.IsErrorStatus(); got != tt.wantError {
+				t.Errorf("StreamResponse.IsErrorStatus() with status %d = %v, want %v", tt.statusCode, got, tt.wantError)
+			}
+		})
+	}
+}
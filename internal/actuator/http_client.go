@@ -1,7 +1,10 @@
 package actuator
 
 import (
+	"context"
 	"encoding/json"
+	"io"
+	"net/http"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -10,20 +13,35 @@ type Response struct {
 	Body       []byte
 	StatusCode int
 	Status     string
+	Header     http.Header
 }
 
 func (r *Response) IsErrorStatus() bool {
 	return r.StatusCode < 200 || r.StatusCode >= 300
 }
 
+// StreamResponse is a response whose body has not been read yet.
+// The caller is responsible for closing Body.
+type StreamResponse struct {
+	Body          io.ReadCloser
+	ContentLength int64
+	StatusCode    int
+	Status        string
+}
+
+func (r *StreamResponse) IsErrorStatus() bool {
+	return r.StatusCode < 200 || r.StatusCode >= 300
+}
+
 type restyHTTPClient struct {
-	resty *resty.Client
+	resty     *resty.Client
+	streaming *resty.Client
 }
 
 var _ HTTPClient = (*restyHTTPClient)(nil)
 
-func newRestyHTTPClient(client *resty.Client) HTTPClient {
-	return &restyHTTPClient{resty: client}
+func newRestyHTTPClient(client *resty.Client, streamingClient *resty.Client) HTTPClient {
+	return &restyHTTPClient{resty: client, streaming: streamingClient}
 }
 
 func (c *restyHTTPClient) Get(path string) (*Response, error) {
@@ -35,6 +53,20 @@ func (c *restyHTTPClient) Get(path string) (*Response, error) {
 		Body:       response.Body(),
 		StatusCode: response.StatusCode(),
 		Status:     response.Status(),
+		Header:     response.Header(),
+	}, nil
+}
+
+func (c *restyHTTPClient) GetWithHeaders(path string, headers map[string]string) (*Response, error) {
+	response, err := c.resty.R().SetHeaders(headers).Get(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Response{
+		Body:       response.Body(),
+		StatusCode: response.StatusCode(),
+		Status:     response.Status(),
+		Header:     response.Header(),
 	}, nil
 }
 
@@ -47,6 +79,36 @@ func (c *restyHTTPClient) Post(path string, body interface{}) (*Response, error)
 		Body:       response.Body(),
 		StatusCode: response.StatusCode(),
 		Status:     response.Status(),
+		Header:     response.Header(),
+	}, nil
+}
+
+func (c *restyHTTPClient) Delete(path string) (*Response, error) {
+	response, err := c.resty.R().Delete(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Response{
+		Body:       response.Body(),
+		StatusCode: response.StatusCode(),
+		Status:     response.Status(),
+		Header:     response.Header(),
+	}, nil
+}
+
+func (c *restyHTTPClient) GetStream(ctx context.Context, path string) (*StreamResponse, error) {
+	response, err := c.streaming.R().
+		SetContext(ctx).
+		SetDoNotParseResponse(true).
+		Get(path)
+	if err != nil {
+		return nil, err
+	}
+	return &StreamResponse{
+		Body:          response.RawBody(),
+		ContentLength: response.RawResponse.ContentLength,
+		StatusCode:    response.StatusCode(),
+		Status:        response.Status(),
 	}, nil
 }
 
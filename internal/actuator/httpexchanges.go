@@ -0,0 +1,129 @@
+package actuator
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+func (c *actuatorClient) GetHTTPExchanges() (*HTTPExchangesResponse, error) {
+	var response HTTPExchangesResponse
+	if err := c.getAndParse("/httpexchanges", "httpexchanges", "failed to get http exchanges", &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetHTTPTrace returns the recorded exchanges of Spring Boot 2 applications, which expose them
+// as "traces" on the httptrace endpoint.
+func (c *actuatorClient) GetHTTPTrace() (*HTTPExchangesResponse, error) {
+	var response httpTraceResponse
+	if err := c.getAndParse("/httptrace", "httptrace", "failed to get http trace", &response); err != nil {
+		return nil, err
+	}
+	return &HTTPExchangesResponse{Exchanges: response.Traces}, nil
+}
+
+type HTTPExchangesResponse struct {
+	Exchanges []HTTPExchange `json:"exchanges"`
+}
+
+type httpTraceResponse struct {
+	Traces []HTTPExchange `json:"traces"`
+}
+
+type HTTPExchange struct {
+	Timestamp string                 `json:"timestamp"`
+	Request   HTTPExchangeRequest    `json:"request"`
+	Response  HTTPExchangeResponse   `json:"response"`
+	Principal *HTTPExchangePrincipal `json:"principal,omitempty"`
+	Session   *HTTPExchangeSession   `json:"session,omitempty"`
+	TimeTaken Duration               `json:"timeTaken"`
+}
+
+type HTTPExchangeRequest struct {
+	URI           string              `json:"uri"`
+	Method        string              `json:"method"`
+	Headers       map[string][]string `json:"headers,omitempty"`
+	RemoteAddress string              `json:"remoteAddress,omitempty"`
+}
+
+type HTTPExchangeResponse struct {
+	Status  int                 `json:"status"`
+	Headers map[string][]string `json:"headers,omitempty"`
+}
+
+type HTTPExchangePrincipal struct {
+	Name string `json:"name"`
+}
+
+type HTTPExchangeSession struct {
+	ID string `json:"id"`
+}
+
+// Duration is a duration serialized either as an ISO-8601 string (e.g. "PT0.023S", Spring Boot 3)
+// or as a number of milliseconds (Spring Boot 2).
+type Duration struct {
+	time.Duration
+}
+
+var isoDurationPattern = regexp.MustCompile(`^PT(?:(-?\d+)H)?(?:(-?\d+)M)?(?:(-?\d+(?:\.\d+)?)S)?$`)
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		d.Duration = 0
+		return nil
+	}
+
+	var millis float64
+	if err := json.Unmarshal(data, &millis); err == nil {
+		d.Duration = time.Duration(math.Round(millis * float64(time.Millisecond)))
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid duration %s", string(data))
+	}
+
+	parsed, err := parseISODuration(s)
+	if err != nil {
+		return err
+	}
+	d.Duration = parsed
+	return nil
+}
+
+// parseISODuration parses the ISO-8601 representation produced by java.time.Duration#toString.
+func parseISODuration(s string) (time.Duration, error) {
+	matches := isoDurationPattern.FindStringSubmatch(s)
+	if matches == nil || s == "PT" {
+		return 0, fmt.Errorf("invalid ISO-8601 duration %q", s)
+	}
+
+	var d time.Duration
+	units := []time.Duration{time.Hour, time.Minute}
+	for i, unit := range units {
+		if matches[i+1] == "" {
+			continue
+		}
+		value, err := strconv.ParseInt(matches[i+1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO-8601 duration %q: %w", s, err)
+		}
+		d += time.Duration(value) * unit
+	}
+
+	if matches[3] != "" {
+		seconds, err := strconv.ParseFloat(matches[3], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ISO-8601 duration %q: %w", s, err)
+		}
+		d += time.Duration(math.Round(seconds * float64(time.Second)))
+	}
+
+	return d, nil
+}
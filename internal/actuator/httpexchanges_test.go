@@ -0,0 +1,176 @@
+package actuator
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestActuatorClientGetHTTPExchanges(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  string
+		mockStatus    int
+		wantErr       bool
+		wantExchanges int
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"exchanges": [
+					{
+						"timestamp": "2024-01-15T10:30:00.123Z",
+						"request": {"uri": "http://localhost:8080/api/orders", "method": "GET", "headers": {}},
+						"response": {"status": 200, "headers": {}},
+						"timeTaken": "PT0.023S"
+					}
+				]
+			}`,
+			mockStatus:    200,
+			wantErr:       false,
+			wantExchanges: 1,
+		},
+		{
+			name:         "404 endpoint not found",
+			mockResponse: ``,
+			mockStatus:   404,
+			wantErr:      true,
+		},
+		{
+			name:         "malformed JSON",
+			mockResponse: `{"exchanges": invalid}`,
+			mockStatus:   200,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				GetFunc: func(path string) (*Response, error) {
+					if path != "/httpexchanges" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.GetHTTPExchanges()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetHTTPExchanges() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && len(result.Exchanges) != tt.wantExchanges {
+				t.Errorf("got %d exchanges, want %d", len(result.Exchanges), tt.wantExchanges)
+			}
+		})
+	}
+}
+
+func TestActuatorClientGetHTTPTrace(t *testing.T) {
+	response := `{
+		"traces": [
+			{
+				"timestamp": "2024-01-15T10:30:00.123Z",
+				"principal": {"name": "alice"},
+				"session": {"id": "ABC123"},
+				"request": {"method": "POST", "uri": "http://localhost:8080/api/orders", "headers": {}, "remoteAddress": "10.0.0.1"},
+				"response": {"status": 201, "headers": {}},
+				"timeTaken": 42
+			}
+		]
+	}`
+
+	mockClient := &MockHTTPClient{
+		GetFunc: func(path string) (*Response, error) {
+			if path != "/httptrace" {
+				t.Errorf("unexpected path: %s", path)
+			}
+			return &Response{Body: []byte(response), StatusCode: 200, Status: "200"}, nil
+		},
+	}
+
+	client := &actuatorClient{httpClient: mockClient}
+	result, err := client.GetHTTPTrace()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Exchanges) != 1 {
+		t.Fatalf("expected 1 exchange, got %d", len(result.Exchanges))
+	}
+	exchange := result.Exchanges[0]
+	if exchange.Request.Method != "POST" || exchange.Response.Status != 201 {
+		t.Errorf("unexpected exchange: %+v", exchange)
+	}
+	if exchange.TimeTaken.Duration != 42*time.Millisecond {
+		t.Errorf("expected time taken 42ms, got %v", exchange.TimeTaken.Duration)
+	}
+	if exchange.Principal == nil || exchange.Principal.Name != "alice" {
+		t.Errorf("expected principal 'alice', got %+v", exchange.Principal)
+	}
+	if exchange.Session == nil || exchange.Session.ID != "ABC123" {
+		t.Errorf("expected session 'ABC123', got %+v", exchange.Session)
+	}
+	if exchange.Request.RemoteAddress != "10.0.0.1" {
+		t.Errorf("expected remote address '10.0.0.1', got '%s'", exchange.Request.RemoteAddress)
+	}
+}
+
+func TestActuatorClientGetHTTPTraceEndpointError(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		GetFunc: func(path string) (*Response, error) {
+			return &Response{StatusCode: 404, Status: "404 Not Found"}, nil
+		},
+	}
+
+	client := &actuatorClient{httpClient: mockClient}
+	if _, err := client.GetHTTPTrace(); err == nil {
+		t.Error("expected error for missing httptrace endpoint")
+	}
+}
+
+func TestDurationUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "milliseconds", json: `42`, want: 42 * time.Millisecond},
+		{name: "fractional milliseconds", json: `1.5`, want: 1500 * time.Microsecond},
+		{name: "iso seconds", json: `"PT0.023S"`, want: 23 * time.Millisecond},
+		{name: "iso zero", json: `"PT0S"`, want: 0},
+		{name: "iso minutes and seconds", json: `"PT1M2.5S"`, want: time.Minute + 2500*time.Millisecond},
+		{name: "iso hours", json: `"PT2H"`, want: 2 * time.Hour},
+		{name: "iso negative", json: `"PT-0.5S"`, want: -500 * time.Millisecond},
+		{name: "null", json: `null`, want: 0},
+		{name: "invalid string", json: `"23ms"`, wantErr: true},
+		{name: "empty iso duration", json: `"PT"`, wantErr: true},
+		{name: "invalid type", json: `true`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Duration
+			err := json.Unmarshal([]byte(tt.json), &d)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Duration.UnmarshalJSON(%s) error = %v, wantErr %v", tt.json, err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && d.Duration != tt.want {
+				t.Errorf("Duration.UnmarshalJSON(%s) = %v, want %v", tt.json, d.Duration, tt.want)
+			}
+		})
+	}
+}
@@ -9,18 +9,43 @@ type Client interface {
 	GetInfo() (map[string]interface{}, error)
 	GetHealth() (*HealthResponse, error)
 	GetMetrics() (*MetricsListResponse, error)
-	GetMetric(metricName string) (*MetricResponse, error)
+	GetMetric(metricName string, tags ...string) (*MetricResponse, error)
+	GetPrometheus(openMetrics bool) (*PrometheusResponse, error)
 	GetEnv() (*EnvResponse, error)
 	GetEnvProperty(propertyName string) (*EnvPropertyResponse, error)
 	GetThreadDump() (*ThreadDumpResponse, error)
 	GetBeans() (*BeansResponse, error)
+	GetCaches() (*CachesResponse, error)
+	EvictCache(cacheName string, cacheManager string) error
+	EvictAllCaches() error
+	GetMappings() (*MappingsResponse, error)
+	GetConditions() (*ConditionsResponse, error)
+	GetConfigProps() (*ConfigPropsResponse, error)
+	GetHTTPExchanges() (*HTTPExchangesResponse, error)
+	GetHTTPTrace() (*HTTPExchangesResponse, error)
+	GetStartup() (*StartupResponse, error)
+	GetFlyway() (*FlywayResponse, error)
+	GetLiquibase() (*LiquibaseResponse, error)
+	GetQuartzJobGroups() (*QuartzJobGroupsResponse, error)
+	GetQuartzJob(group string, name string) (*QuartzJobDetail, error)
+	GetQuartzTriggerGroups() (*QuartzTriggerGroupsResponse, error)
+	GetQuartzTrigger(group string, name string) (*QuartzTriggerDetail, error)
+	TriggerQuartzJob(group string, name string) (*QuartzJobTriggerResponse, error)
+	Refresh() ([]string, error)
+	BusRefresh() error
+	Shutdown() (*ShutdownResponse, error)
+	GetHeapDump(ctx context.Context) (*StreamResponse, error)
+	GetLogFile(byteRange LogFileRange) (*LogFileChunk, error)
 	GetRaw(endpoint string) ([]byte, error)
 	GetAvailableEndpoints() ([]string, error)
 }
 
 type HTTPClient interface {
 	Get(path string) (*Response, error)
+	GetWithHeaders(path string, headers map[string]string) (*Response, error)
 	Post(path string, body interface{}) (*Response, error)
+	Delete(path string) (*Response, error)
+	GetStream(ctx context.Context, path string) (*StreamResponse, error)
 }
 
 type ClientFactory interface {
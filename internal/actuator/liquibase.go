@@ -0,0 +1,38 @@
+package actuator
+
+func (c *actuatorClient) GetLiquibase() (*LiquibaseResponse, error) {
+	var response LiquibaseResponse
+	if err := c.getAndParse("/liquibase", "liquibase", "failed to get liquibase change sets", &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+type LiquibaseResponse struct {
+	Contexts map[string]LiquibaseContext `json:"contexts"`
+}
+
+type LiquibaseContext struct {
+	LiquibaseBeans map[string]LiquibaseBean `json:"liquibaseBeans"`
+	ParentID       string                   `json:"parentId,omitempty"`
+}
+
+type LiquibaseBean struct {
+	ChangeSets []LiquibaseChangeSet `json:"changeSets"`
+}
+
+type LiquibaseChangeSet struct {
+	ID            string   `json:"id"`
+	Author        string   `json:"author"`
+	ChangeLog     string   `json:"changeLog"`
+	Comments      string   `json:"comments,omitempty"`
+	Contexts      []string `json:"contexts,omitempty"`
+	DateExecuted  string   `json:"dateExecuted"`
+	DeploymentID  string   `json:"deploymentId,omitempty"`
+	Description   string   `json:"description"`
+	ExecType      string   `json:"execType"`
+	Labels        []string `json:"labels,omitempty"`
+	Checksum      string   `json:"checksum"`
+	OrderExecuted int      `json:"orderExecuted"`
+	Tag           string   `json:"tag,omitempty"`
+}
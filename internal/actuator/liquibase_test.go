@@ -0,0 +1,134 @@
+package actuator
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestActuatorClientGetLiquibase(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockResponse string
+		mockStatus   int
+		wantErr      bool
+		wantBeansCnt int
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"contexts": {
+					"application": {
+						"liquibaseBeans": {
+							"liquibase": {"changeSets": []}
+						}
+					}
+				}
+			}`,
+			mockStatus:   200,
+			wantErr:      false,
+			wantBeansCnt: 1,
+		},
+		{
+			name:         "404 endpoint not found",
+			mockResponse: ``,
+			mockStatus:   404,
+			wantErr:      true,
+		},
+		{
+			name:         "malformed JSON",
+			mockResponse: `{"contexts": invalid}`,
+			mockStatus:   200,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				GetFunc: func(path string) (*Response, error) {
+					if path != "/liquibase" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.GetLiquibase()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetLiquibase() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && len(result.Contexts["application"].LiquibaseBeans) != tt.wantBeansCnt {
+				t.Errorf("got %d liquibase beans, want %d", len(result.Contexts["application"].LiquibaseBeans), tt.wantBeansCnt)
+			}
+		})
+	}
+}
+
+func TestLiquibaseResponseParsing(t *testing.T) {
+	response := `{
+		"contexts": {
+			"application": {
+				"liquibaseBeans": {
+					"liquibase": {
+						"changeSets": [
+							{
+								"author": "alice",
+								"changeLog": "db/changelog/db.changelog-master.yaml",
+								"comments": "",
+								"contexts": [],
+								"dateExecuted": "2024-01-15T10:30:00.000Z",
+								"deploymentId": "5312345678",
+								"description": "createTable tableName=orders",
+								"execType": "EXECUTED",
+								"id": "1",
+								"labels": [],
+								"checksum": "9:d3589936b6a2e1b4a1b6c5c8f3a1e2d4",
+								"orderExecuted": 1,
+								"tag": null
+							}
+						]
+					}
+				}
+			}
+		}
+	}`
+
+	mockClient := &MockHTTPClient{
+		GetFunc: func(path string) (*Response, error) {
+			return &Response{Body: []byte(response), StatusCode: 200, Status: "200"}, nil
+		},
+	}
+
+	client := &actuatorClient{httpClient: mockClient}
+	result, err := client.GetLiquibase()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	changeSets := result.Contexts["application"].LiquibaseBeans["liquibase"].ChangeSets
+	if len(changeSets) != 1 {
+		t.Fatalf("expected 1 change set, got %d", len(changeSets))
+	}
+
+	changeSet := changeSets[0]
+	if changeSet.ID != "1" || changeSet.Author != "alice" {
+		t.Errorf("unexpected change set identity: %+v", changeSet)
+	}
+	if changeSet.ExecType != "EXECUTED" || changeSet.OrderExecuted != 1 {
+		t.Errorf("unexpected execution details: %+v", changeSet)
+	}
+	if changeSet.Checksum != "9:d3589936b6a2e1b4a1b6c5c8f3a1e2d4" {
+		t.Errorf("unexpected checksum '%s'", changeSet.Checksum)
+	}
+	if changeSet.Tag != "" {
+		t.Errorf("expected empty tag, got '%s'", changeSet.Tag)
+	}
+}
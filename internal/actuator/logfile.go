@@ -0,0 +1,108 @@
+package actuator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// GetLogFile returns the requested part of the log file using an HTTP Range request.
+// If the range starts at or beyond the end of the file, the returned chunk is empty; its Size
+// tells whether the file has grown, stayed the same or was truncated in the meantime.
+func (c *actuatorClient) GetLogFile(byteRange LogFileRange) (*LogFileChunk, error) {
+	headers := map[string]string{}
+	if header := byteRange.header(); header != "" {
+		headers["Range"] = header
+	}
+
+	resp, err := c.httpClient.GetWithHeaders("/logfile", headers)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// The whole file, either because no range was requested or because ranges are not supported
+		return &LogFileChunk{Content: resp.Body, Offset: 0, Size: int64(len(resp.Body))}, nil
+	case http.StatusPartialContent:
+		start, size, err := parseContentRange(resp.Header.Get("Content-Range"))
+		if err != nil {
+			return nil, err
+		}
+		return &LogFileChunk{Content: resp.Body, Offset: start, Size: size}, nil
+	case http.StatusRequestedRangeNotSatisfiable:
+		_, size, err := parseContentRange(resp.Header.Get("Content-Range"))
+		if err != nil {
+			return nil, err
+		}
+		return &LogFileChunk{Offset: byteRange.Offset, Size: size}, nil
+	case http.StatusNotFound:
+		// The endpoint is also missing if it is exposed, but the application does not log to a file
+		return nil, fmt.Errorf("failed to get log file: %s\nMake sure logging.file.name or logging.file.path is set and the 'logfile' endpoint is exposed "+
+			"in your Spring Boot configuration: https://docs.spring.io/spring-boot/reference/actuator/endpoints.html", resp.Status)
+	}
+
+	return nil, endpointError("logfile", resp.Status, "failed to get log file")
+}
+
+// parseContentRange parses a Content-Range header like "bytes 100-199/1000" or "bytes */1000".
+// An unknown total size is returned as -1.
+func parseContentRange(header string) (start int64, size int64, err error) {
+	spec, found := strings.CutPrefix(header, "bytes ")
+	if !found {
+		return 0, 0, fmt.Errorf("invalid Content-Range header %q", header)
+	}
+
+	byteRange, total, found := strings.Cut(spec, "/")
+	if !found {
+		return 0, 0, fmt.Errorf("invalid Content-Range header %q", header)
+	}
+
+	size = -1
+	if total != "*" {
+		if size, err = strconv.ParseInt(total, 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("invalid Content-Range header %q", header)
+		}
+	}
+
+	if byteRange == "*" {
+		return 0, size, nil
+	}
+
+	first, _, found := strings.Cut(byteRange, "-")
+	if !found {
+		return 0, 0, fmt.Errorf("invalid Content-Range header %q", header)
+	}
+	if start, err = strconv.ParseInt(first, 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("invalid Content-Range header %q", header)
+	}
+
+	return start, size, nil
+}
+
+// LogFileRange selects the part of the log file to return. The zero value selects the whole file.
+type LogFileRange struct {
+	// Offset is the position of the first byte to return
+	Offset int64
+	// Tail, if positive, selects the last Tail bytes instead
+	Tail int64
+}
+
+func (r LogFileRange) header() string {
+	switch {
+	case r.Tail > 0:
+		return fmt.Sprintf("bytes=-%d", r.Tail)
+	case r.Offset > 0:
+		return fmt.Sprintf("bytes=%d-", r.Offset)
+	}
+	return ""
+}
+
+type LogFileChunk struct {
+	Content []byte
+	// Offset is the position of Content in the log file
+	Offset int64
+	// Size is the total size of the log file, or -1 if unknown
+	Size int64
+}
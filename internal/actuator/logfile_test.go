@@ -0,0 +1,188 @@
+package actuator
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestActuatorClientGetLogFile(t *testing.T) {
+	tests := []struct {
+		name             string
+		byteRange        LogFileRange
+		mockResponse     string
+		mockStatus       int
+		mockContentRange string
+		wantRangeHeader  string
+		wantErr          bool
+		errContains      string
+		wantContent      string
+		wantOffset       int64
+		wantSize         int64
+	}{
+		{
+			name:         "whole file",
+			mockResponse: "line 1\nline 2\n",
+			mockStatus:   200,
+			wantContent:  "line 1\nline 2\n",
+			wantOffset:   0,
+			wantSize:     14,
+		},
+		{
+			name:             "tail bytes",
+			byteRange:        LogFileRange{Tail: 7},
+			mockResponse:     "line 2\n",
+			mockStatus:       206,
+			mockContentRange: "bytes 7-13/14",
+			wantRangeHeader:  "bytes=-7",
+			wantContent:      "line 2\n",
+			wantOffset:       7,
+			wantSize:         14,
+		},
+		{
+			name:             "from offset",
+			byteRange:        LogFileRange{Offset: 14},
+			mockResponse:     "line 3\n",
+			mockStatus:       206,
+			mockContentRange: "bytes 14-20/21",
+			wantRangeHeader:  "bytes=14-",
+			wantContent:      "line 3\n",
+			wantOffset:       14,
+			wantSize:         21,
+		},
+		{
+			name:             "no new content",
+			byteRange:        LogFileRange{Offset: 21},
+			mockStatus:       416,
+			mockContentRange: "bytes */21",
+			wantRangeHeader:  "bytes=21-",
+			wantOffset:       21,
+			wantSize:         21,
+		},
+		{
+			name:             "file truncated",
+			byteRange:        LogFileRange{Offset: 21},
+			mockStatus:       416,
+			mockContentRange: "bytes */5",
+			wantRangeHeader:  "bytes=21-",
+			wantOffset:       21,
+			wantSize:         5,
+		},
+		{
+			name:            "range ignored by server",
+			byteRange:       LogFileRange{Offset: 7},
+			mockResponse:    "line 1\nline 2\n",
+			mockStatus:      200,
+			wantRangeHeader: "bytes=7-",
+			wantContent:     "line 1\nline 2\n",
+			wantOffset:      0,
+			wantSize:        14,
+		},
+		{
+			name:             "invalid content range",
+			byteRange:        LogFileRange{Tail: 7},
+			mockResponse:     "line 2\n",
+			mockStatus:       206,
+			mockContentRange: "items 7-13/14",
+			wantRangeHeader:  "bytes=-7",
+			wantErr:          true,
+			errContains:      "invalid Content-Range header",
+		},
+		{
+			name:        "no log file",
+			mockStatus:  404,
+			wantErr:     true,
+			errContains: "logging.file.name",
+		},
+		{
+			name:        "500 internal server error",
+			mockStatus:  500,
+			wantErr:     true,
+			errContains: "failed to get log file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				GetWithHeadersFunc: func(path string, headers map[string]string) (*Response, error) {
+					if path != "/logfile" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					if headers["Range"] != tt.wantRangeHeader {
+						t.Errorf("Range header = %q, want %q", headers["Range"], tt.wantRangeHeader)
+					}
+					header := http.Header{}
+					if tt.mockContentRange != "" {
+						header.Set("Content-Range", tt.mockContentRange)
+					}
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+						Header:     header,
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			chunk, err := client.GetLogFile(tt.byteRange)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetLogFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				if tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			if string(chunk.Content) != tt.wantContent {
+				t.Errorf("content = %q, want %q", chunk.Content, tt.wantContent)
+			}
+			if chunk.Offset != tt.wantOffset {
+				t.Errorf("offset = %d, want %d", chunk.Offset, tt.wantOffset)
+			}
+			if chunk.Size != tt.wantSize {
+				t.Errorf("size = %d, want %d", chunk.Size, tt.wantSize)
+			}
+		})
+	}
+}
+
+func TestParseContentRange(t *testing.T) {
+	tests := []struct {
+		header    string
+		wantStart int64
+		wantSize  int64
+		wantErr   bool
+	}{
+		{header: "bytes 0-99/1000", wantStart: 0, wantSize: 1000},
+		{header: "bytes 900-999/1000", wantStart: 900, wantSize: 1000},
+		{header: "bytes 900-999/*", wantStart: 900, wantSize: -1},
+		{header: "bytes */1000", wantStart: 0, wantSize: 1000},
+		{header: "", wantErr: true},
+		{header: "bytes 900/1000", wantErr: true},
+		{header: "bytes a-b/1000", wantErr: true},
+		{header: "bytes 0-99/many", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			start, size, err := parseContentRange(tt.header)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseContentRange(%q) error = %v, wantErr %v", tt.header, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if start != tt.wantStart || size != tt.wantSize {
+				t.Errorf("parseContentRange(%q) = %d, %d, want %d, %d", tt.header, start, size, tt.wantStart, tt.wantSize)
+			}
+		})
+	}
+}
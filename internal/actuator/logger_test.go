@@ -1,8 +1,11 @@
 package actuator
 
 import (
+	"context"
 	"encoding/json"
+	"io"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -95,8 +98,11 @@ func TestResponseIsErrorStatus(t *testing.T) {
 }
 
 type MockHTTPClient struct {
-	GetFunc  func(path string) (*Response, error)
-	PostFunc func(path string, body interface{}) (*Response, error)
+	GetFunc            func(path string) (*Response, error)
+	GetWithHeadersFunc func(path string, headers map[string]string) (*Response, error)
+	PostFunc           func(path string, body interface{}) (*Response, error)
+	DeleteFunc         func(path string) (*Response, error)
+	GetStreamFunc      func(ctx context.Context, path string) (*StreamResponse, error)
 }
 
 func (m *MockHTTPClient) Get(path string) (*Response, error) {
@@ -106,6 +112,13 @@ func (m *MockHTTPClient) Get(path string) (*Response, error) {
 	return &Response{Body: nil, StatusCode: 200, Status: "200 OK"}, nil
 }
 
+func (m *MockHTTPClient) GetWithHeaders(path string, headers map[string]string) (*Response, error) {
+	if m.GetWithHeadersFunc != nil {
+		return m.GetWithHeadersFunc(path, headers)
+	}
+	return m.Get(path)
+}
+
 func (m *MockHTTPClient) Post(path string, body interface{}) (*Response, error) {
 	if m.PostFunc != nil {
 		return m.PostFunc(path, body)
@@ -113,6 +126,20 @@ func (m *MockHTTPClient) Post(path string, body interface{}) (*Response, error)
 	return &Response{Body: nil, StatusCode: 200, Status: "200 OK"}, nil
 }
 
+func (m *MockHTTPClient) Delete(path string) (*Response, error) {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(path)
+	}
+	return &Response{Body: nil, StatusCode: 204, Status: "204 No Content"}, nil
+}
+
+func (m *MockHTTPClient) GetStream(ctx context.Context, path string) (*StreamResponse, error) {
+	if m.GetStreamFunc != nil {
+		return m.GetStreamFunc(ctx, path)
+	}
+	return &StreamResponse{Body: io.NopCloser(strings.NewReader("")), StatusCode: 200, Status: "200 OK"}, nil
+}
+
 func TestActuatorClientGetLoggers(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -0,0 +1,82 @@
+package actuator
+
+func (c *actuatorClient) GetMappings() (*MappingsResponse, error) {
+	var mappingsResponse MappingsResponse
+	if err := c.getAndParse("/mappings", "mappings", "failed to get mappings", &mappingsResponse); err != nil {
+		return nil, err
+	}
+	return &mappingsResponse, nil
+}
+
+type MappingsResponse struct {
+	Contexts map[string]MappingsContext `json:"contexts"`
+}
+
+type MappingsContext struct {
+	Mappings ContextMappings `json:"mappings"`
+	ParentID string          `json:"parentId,omitempty"`
+}
+
+type ContextMappings struct {
+	// DispatcherServlets maps each Spring MVC dispatcher servlet name to its request mappings
+	DispatcherServlets map[string][]DispatcherMapping `json:"dispatcherServlets,omitempty"`
+	// DispatcherHandlers maps each WebFlux dispatcher handler name to its request mappings
+	DispatcherHandlers map[string][]DispatcherMapping `json:"dispatcherHandlers,omitempty"`
+	ServletFilters     []ServletFilterMapping         `json:"servletFilters,omitempty"`
+	Servlets           []ServletMapping               `json:"servlets,omitempty"`
+}
+
+type DispatcherMapping struct {
+	Handler   string                    `json:"handler"`
+	Predicate string                    `json:"predicate"`
+	Details   *DispatcherMappingDetails `json:"details,omitempty"`
+}
+
+type DispatcherMappingDetails struct {
+	HandlerMethod            *HandlerMethod            `json:"handlerMethod,omitempty"`
+	HandlerFunction          *HandlerFunction          `json:"handlerFunction,omitempty"`
+	RequestMappingConditions *RequestMappingConditions `json:"requestMappingConditions,omitempty"`
+}
+
+type HandlerMethod struct {
+	ClassName  string `json:"className"`
+	Name       string `json:"name"`
+	Descriptor string `json:"descriptor"`
+}
+
+type HandlerFunction struct {
+	ClassName string `json:"className"`
+}
+
+type RequestMappingConditions struct {
+	Consumes []MediaTypeExpression `json:"consumes"`
+	Headers  []NameValueExpression `json:"headers"`
+	Methods  []string              `json:"methods"`
+	Params   []NameValueExpression `json:"params"`
+	Patterns []string              `json:"patterns"`
+	Produces []MediaTypeExpression `json:"produces"`
+}
+
+type MediaTypeExpression struct {
+	MediaType string `json:"mediaType"`
+	Negated   bool   `json:"negated"`
+}
+
+type NameValueExpression struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Negated bool   `json:"negated"`
+}
+
+type ServletFilterMapping struct {
+	Name                string   `json:"name"`
+	ClassName           string   `json:"className"`
+	ServletNameMappings []string `json:"servletNameMappings"`
+	URLPatternMappings  []string `json:"urlPatternMappings"`
+}
+
+type ServletMapping struct {
+	Name      string   `json:"name"`
+	ClassName string   `json:"className"`
+	Mappings  []string `json:"mappings"`
+}
@@ -0,0 +1,270 @@
+package actuator
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestActuatorClientGetMappings(t *testing.T) {
+	tests := []struct {
+		name            string
+		mockResponse    string
+		mockStatus      int
+		wantErr         bool
+		wantContextsCnt int
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"contexts": {
+					"application": {
+						"mappings": {
+							"dispatcherServlets": {"dispatcherServlet": []},
+							"servletFilters": [],
+							"servlets": []
+						}
+					}
+				}
+			}`,
+			mockStatus:      200,
+			wantErr:         false,
+			wantContextsCnt: 1,
+		},
+		{
+			name:         "404 endpoint not found",
+			mockResponse: ``,
+			mockStatus:   404,
+			wantErr:      true,
+		},
+		{
+			name:         "malformed JSON",
+			mockResponse: `{"contexts": invalid}`,
+			mockStatus:   200,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				GetFunc: func(path string) (*Response, error) {
+					if path != "/mappings" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.GetMappings()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetMappings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && len(result.Contexts) != tt.wantContextsCnt {
+				t.Errorf("got %d contexts, want %d", len(result.Contexts), tt.wantContextsCnt)
+			}
+		})
+	}
+}
+
+func TestMappingsResponseParsing(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		validate func(*testing.T, *MappingsResponse)
+	}{
+		{
+			name: "dispatcher servlet mapping with handler method",
+			response: `{
+				"contexts": {
+					"application": {
+						"mappings": {
+							"dispatcherServlets": {
+								"dispatcherServlet": [
+									{
+										"handler": "com.example.OrderController#list()",
+										"predicate": "{GET [/api/orders], produces [application/json]}",
+										"details": {
+											"handlerMethod": {
+												"className": "com.example.OrderController",
+												"name": "list",
+												"descriptor": "()Ljava/util/List;"
+											},
+											"requestMappingConditions": {
+												"consumes": [],
+												"headers": [],
+												"methods": ["GET"],
+												"params": [{"name": "page", "value": null, "negated": false}],
+												"patterns": ["/api/orders"],
+												"produces": [{"mediaType": "application/json", "negated": false}]
+											}
+										}
+									}
+								]
+							}
+						}
+					}
+				}
+			}`,
+			validate: func(t *testing.T, resp *MappingsResponse) {
+				mappings := resp.Contexts["application"].Mappings.DispatcherServlets["dispatcherServlet"]
+				if len(mappings) != 1 {
+					t.Fatalf("expected 1 mapping, got %d", len(mappings))
+				}
+				details := mappings[0].Details
+				if details == nil || details.HandlerMethod == nil || details.RequestMappingConditions == nil {
+					t.Fatal("expected handler method and request mapping conditions")
+				}
+				if details.HandlerMethod.ClassName != "com.example.OrderController" {
+					t.Errorf("expected class 'com.example.OrderController', got '%s'", details.HandlerMethod.ClassName)
+				}
+				if details.HandlerMethod.Name != "list" {
+					t.Errorf("expected method 'list', got '%s'", details.HandlerMethod.Name)
+				}
+				conditions := details.RequestMappingConditions
+				if len(conditions.Methods) != 1 || conditions.Methods[0] != "GET" {
+					t.Errorf("expected methods [GET], got %v", conditions.Methods)
+				}
+				if len(conditions.Patterns) != 1 || conditions.Patterns[0] != "/api/orders" {
+					t.Errorf("expected patterns [/api/orders], got %v", conditions.Patterns)
+				}
+				if len(conditions.Produces) != 1 || conditions.Produces[0].MediaType != "application/json" {
+					t.Errorf("expected produces application/json, got %v", conditions.Produces)
+				}
+				if len(conditions.Params) != 1 || conditions.Params[0].Name != "page" {
+					t.Errorf("expected param 'page', got %v", conditions.Params)
+				}
+			},
+		},
+		{
+			name: "resource handler without details",
+			response: `{
+				"contexts": {
+					"application": {
+						"mappings": {
+							"dispatcherServlets": {
+								"dispatcherServlet": [
+									{
+										"handler": "ResourceHttpRequestHandler [classpath [META-INF/resources/webjars/]]",
+										"predicate": "/webjars/**"
+									}
+								]
+							}
+						}
+					}
+				}
+			}`,
+			validate: func(t *testing.T, resp *MappingsResponse) {
+				mapping := resp.Contexts["application"].Mappings.DispatcherServlets["dispatcherServlet"][0]
+				if mapping.Details != nil {
+					t.Errorf("expected no details, got %+v", mapping.Details)
+				}
+				if mapping.Predicate != "/webjars/**" {
+					t.Errorf("expected predicate '/webjars/**', got '%s'", mapping.Predicate)
+				}
+			},
+		},
+		{
+			name: "servlets and servlet filters",
+			response: `{
+				"contexts": {
+					"application": {
+						"mappings": {
+							"servletFilters": [
+								{
+									"servletNameMappings": [],
+									"urlPatternMappings": ["/*"],
+									"name": "characterEncodingFilter",
+									"className": "org.springframework.boot.web.servlet.filter.OrderedCharacterEncodingFilter"
+								}
+							],
+							"servlets": [
+								{
+									"mappings": ["/"],
+									"name": "dispatcherServlet",
+									"className": "org.springframework.web.servlet.DispatcherServlet"
+								}
+							]
+						}
+					}
+				}
+			}`,
+			validate: func(t *testing.T, resp *MappingsResponse) {
+				mappings := resp.Contexts["application"].Mappings
+				if len(mappings.ServletFilters) != 1 {
+					t.Fatalf("expected 1 servlet filter, got %d", len(mappings.ServletFilters))
+				}
+				if mappings.ServletFilters[0].URLPatternMappings[0] != "/*" {
+					t.Errorf("expected url pattern '/*', got %v", mappings.ServletFilters[0].URLPatternMappings)
+				}
+				if len(mappings.Servlets) != 1 || mappings.Servlets[0].Name != "dispatcherServlet" {
+					t.Errorf("expected dispatcherServlet, got %v", mappings.Servlets)
+				}
+			},
+		},
+		{
+			name: "webflux dispatcher handler with handler function",
+			response: `{
+				"contexts": {
+					"application": {
+						"mappings": {
+							"dispatcherHandlers": {
+								"webHandler": [
+									{
+										"handler": "com.example.Routes$$Lambda/0x123@4567",
+										"predicate": "(GET && /api/users)",
+										"details": {
+											"handlerFunction": {"className": "com.example.Routes$$Lambda/0x123"}
+										}
+									}
+								]
+							}
+						}
+					}
+				}
+			}`,
+			validate: func(t *testing.T, resp *MappingsResponse) {
+				mappings := resp.Contexts["application"].Mappings.DispatcherHandlers["webHandler"]
+				if len(mappings) != 1 {
+					t.Fatalf("expected 1 mapping, got %d", len(mappings))
+				}
+				if mappings[0].Details == nil || mappings[0].Details.HandlerFunction == nil {
+					t.Fatal("expected handler function details")
+				}
+				if mappings[0].Details.HandlerFunction.ClassName != "com.example.Routes$$Lambda/0x123" {
+					t.Errorf("unexpected handler function class '%s'", mappings[0].Details.HandlerFunction.ClassName)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				GetFunc: func(path string) (*Response, error) {
+					return &Response{
+						Body:       []byte(tt.response),
+						StatusCode: 200,
+						Status:     "200",
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.GetMappings()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			tt.validate(t, result)
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package actuator
 
-import "net/url"
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
 
 func (c *actuatorClient) GetMetrics() (*MetricsListResponse, error) {
 	var metricsResponse MetricsListResponse
@@ -10,8 +14,14 @@ func (c *actuatorClient) GetMetrics() (*MetricsListResponse, error) {
 	return &metricsResponse, nil
 }
 
-func (c *actuatorClient) GetMetric(metricName string) (*MetricResponse, error) {
+// GetMetric returns the measurements of a metric. Tags in the form KEY:VALUE restrict the
+// measurements to the meters with these tags.
+func (c *actuatorClient) GetMetric(metricName string, tags ...string) (*MetricResponse, error) {
 	path := "/metrics/" + url.PathEscape(metricName)
+	if len(tags) > 0 {
+		path += "?" + url.Values{"tag": tags}.Encode()
+	}
+
 	resp, err := c.httpClient.Get(path)
 	if err != nil {
 		return nil, err
@@ -19,6 +29,9 @@ func (c *actuatorClient) GetMetric(metricName string) (*MetricResponse, error) {
 
 	if resp.IsErrorStatus() {
 		if resp.StatusCode == 404 && c.isEndpointAccessible("/metrics") {
+			if len(tags) > 0 {
+				return nil, fmt.Errorf("metric '%s' with tags %s not found: %s", metricName, strings.Join(tags, ", "), resp.Status)
+			}
 			return nil, resourceNotFoundError("metric", metricName, resp.Status)
 		}
 		return nil, endpointError("metrics", resp.Status, "failed to get metric")
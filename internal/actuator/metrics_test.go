@@ -2,6 +2,7 @@ package actuator
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -99,6 +100,7 @@ func TestActuatorClientGetMetric(t *testing.T) {
 	tests := []struct {
 		name            string
 		metricName      string
+		tags            []string
 		mockResponse    string
 		mockStatus      int
 		mockErr         error
@@ -162,6 +164,21 @@ func TestActuatorClientGetMetric(t *testing.T) {
 			wantErr:    false,
 			wantPath:   "/metrics/cache.gets%7Bcache=myCache%7D",
 		},
+		{
+			name:       "metric with tags",
+			metricName: "http.server.requests",
+			tags:       []string{"uri:/api/orders", "status:500"},
+			mockResponse: `{
+				"name": "http.server.requests",
+				"baseUnit": "seconds",
+				"measurements": [{"statistic": "COUNT", "value": 2}],
+				"availableTags": [{"tag": "method", "values": ["POST"]}]
+			}`,
+			mockStatus:   200,
+			wantErr:      false,
+			wantPath:     "/metrics/http.server.requests?tag=uri%3A%2Fapi%2Forders&tag=status%3A500",
+			wantBaseUnit: "seconds",
+		},
 		{
 			name:         "metric not found",
 			metricName:   "nonexistent.metric",
@@ -203,7 +220,7 @@ func TestActuatorClientGetMetric(t *testing.T) {
 			}
 
 			client := &actuatorClient{httpClient: mockClient}
-			result, err := client.GetMetric(tt.metricName)
+			result, err := client.GetMetric(tt.metricName, tt.tags...)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetMetric() error = %v, wantErr %v", err, tt.wantErr)
@@ -301,3 +318,24 @@ func TestMetricResponseMeasurements(t *testing.T) {
 		})
 	}
 }
+
+func TestActuatorClientGetMetricWithTagsNotFound(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		GetFunc: func(path string) (*Response, error) {
+			if path == "/metrics" {
+				return &Response{Body: []byte(`{"names": []}`), StatusCode: 200, Status: "200"}, nil
+			}
+			return &Response{StatusCode: 404, Status: "404"}, nil
+		},
+	}
+
+	client := &actuatorClient{httpClient: mockClient}
+	_, err := client.GetMetric("http.server.requests", "uri:/missing")
+
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !strings.Contains(err.Error(), "metric 'http.server.requests' with tags uri:/missing not found") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,223 @@
+package actuator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	prometheusTextContentType  = "text/plain;version=0.0.4"
+	openMetricsTextContentType = "application/openmetrics-text;version=1.0.0"
+)
+
+// GetPrometheus returns all metrics of the prometheus scrape endpoint. If openMetrics is set,
+// the OpenMetrics format is requested, but the endpoint may still answer in the Prometheus text format.
+func (c *actuatorClient) GetPrometheus(openMetrics bool) (*PrometheusResponse, error) {
+	accept := prometheusTextContentType
+	if openMetrics {
+		accept = openMetricsTextContentType + ", " + prometheusTextContentType + ";q=0.5"
+	}
+
+	resp, err := c.httpClient.GetWithHeaders("/prometheus", map[string]string{"Accept": accept})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.IsErrorStatus() {
+		return nil, endpointError("prometheus", resp.Status, "failed to get prometheus metrics")
+	}
+
+	families, err := parsePrometheusText(string(resp.Body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse prometheus metrics: %w", err)
+	}
+
+	return &PrometheusResponse{
+		OpenMetrics: strings.HasPrefix(resp.Header.Get("Content-Type"), "application/openmetrics-text"),
+		Families:    families,
+	}, nil
+}
+
+// prometheusSampleSuffixes are appended to the family name by the samples of counters, summaries and histograms
+var prometheusSampleSuffixes = []string{"_total", "_created", "_count", "_sum", "_bucket", "_gcount", "_gsum", "_info"}
+
+// parsePrometheusText parses the Prometheus text exposition format and the OpenMetrics text format.
+// Timestamps and exemplars are ignored.
+func parsePrometheusText(text string) ([]PrometheusMetricFamily, error) {
+	var families []PrometheusMetricFamily
+	familyIndex := make(map[string]int)
+
+	family := func(name string) *PrometheusMetricFamily {
+		if i, ok := familyIndex[name]; ok {
+			return &families[i]
+		}
+		familyIndex[name] = len(families)
+		families = append(families, PrometheusMetricFamily{Name: name, Type: "untyped"})
+		return &families[len(families)-1]
+	}
+
+	// sampleFamily finds the declared family of a sample, e.g. "requests" for "requests_count"
+	sampleFamily := func(sampleName string) *PrometheusMetricFamily {
+		if _, ok := familyIndex[sampleName]; ok {
+			return family(sampleName)
+		}
+		for _, suffix := range prometheusSampleSuffixes {
+			if name, found := strings.CutSuffix(sampleName, suffix); found {
+				if _, ok := familyIndex[name]; ok {
+					return family(name)
+				}
+			}
+		}
+		return family(sampleName)
+	}
+
+	for lineNumber, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			fields := strings.SplitN(line, " ", 4)
+			if len(fields) == 2 && fields[1] == "EOF" {
+				break
+			}
+			if len(fields) < 3 {
+				continue
+			}
+			value := ""
+			if len(fields) == 4 {
+				value = fields[3]
+			}
+			switch fields[1] {
+			case "HELP":
+				family(fields[2]).Help = unescapePrometheusText(value)
+			case "TYPE":
+				family(fields[2]).Type = value
+			case "UNIT":
+				family(fields[2]).Unit = value
+			}
+			continue
+		}
+
+		sample, err := parsePrometheusSample(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber+1, err)
+		}
+		f := sampleFamily(sample.Name)
+		f.Samples = append(f.Samples, sample)
+	}
+
+	return families, nil
+}
+
+// parsePrometheusSample parses a line like `name{label="value"} 1.5 [timestamp] [# exemplar]`
+func parsePrometheusSample(line string) (PrometheusSample, error) {
+	end := strings.IndexAny(line, "{ ")
+	if end <= 0 {
+		return PrometheusSample{}, fmt.Errorf("invalid sample %q", line)
+	}
+	sample := PrometheusSample{Name: line[:end], Labels: map[string]string{}}
+
+	rest := line[end:]
+	if strings.HasPrefix(rest, "{") {
+		labels, remaining, err := parsePrometheusLabels(rest[1:])
+		if err != nil {
+			return PrometheusSample{}, fmt.Errorf("invalid labels of %s: %w", sample.Name, err)
+		}
+		sample.Labels = labels
+		rest = remaining
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return PrometheusSample{}, fmt.Errorf("missing value of %s", sample.Name)
+	}
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return PrometheusSample{}, fmt.Errorf("invalid value of %s: %q", sample.Name, fields[0])
+	}
+	sample.Value = value
+
+	return sample, nil
+}
+
+// parsePrometheusLabels parses the labels following the opening brace and returns the text after the closing brace
+func parsePrometheusLabels(s string) (map[string]string, string, error) {
+	labels := make(map[string]string)
+	for {
+		s = strings.TrimLeft(s, " ")
+		if strings.HasPrefix(s, "}") {
+			return labels, s[1:], nil
+		}
+
+		name, rest, found := strings.Cut(s, "=")
+		if !found {
+			return nil, "", fmt.Errorf("missing '=' in %q", s)
+		}
+		name = strings.TrimSpace(name)
+
+		value, rest, err := unquotePrometheusLabelValue(strings.TrimLeft(rest, " "))
+		if err != nil {
+			return nil, "", err
+		}
+		labels[name] = value
+
+		s = strings.TrimPrefix(strings.TrimLeft(rest, " "), ",")
+	}
+}
+
+// unquotePrometheusLabelValue reads a quoted label value and returns the text after the closing quote
+func unquotePrometheusLabelValue(s string) (string, string, error) {
+	if !strings.HasPrefix(s, `"`) {
+		return "", "", fmt.Errorf("label value must be quoted: %q", s)
+	}
+
+	var value strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			return value.String(), s[i+1:], nil
+		case '\\':
+			i++
+			if i == len(s) {
+				break
+			}
+			if s[i] == 'n' {
+				value.WriteByte('\n')
+			} else {
+				value.WriteByte(s[i])
+			}
+		default:
+			value.WriteByte(s[i])
+		}
+	}
+	return "", "", fmt.Errorf("unterminated label value: %q", s)
+}
+
+func unescapePrometheusText(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\n`, "\n").Replace(s)
+}
+
+type PrometheusResponse struct {
+	// OpenMetrics is true if the endpoint answered in the OpenMetrics format
+	OpenMetrics bool
+	Families    []PrometheusMetricFamily
+}
+
+type PrometheusMetricFamily struct {
+	Name string
+	// Type is one of counter, gauge, histogram, gaugehistogram, summary, info, stateset or untyped
+	Type    string
+	Help    string
+	Unit    string
+	Samples []PrometheusSample
+}
+
+type PrometheusSample struct {
+	// Name is the sample name, which may extend the family name by a suffix like _count or _bucket
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
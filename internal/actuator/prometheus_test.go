@@ -0,0 +1,309 @@
+package actuator
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const testPrometheusText = `# HELP http_server_requests_seconds Duration of HTTP server request handling
+# TYPE http_server_requests_seconds summary
+http_server_requests_seconds_count{method="GET",status="200",uri="/api/orders"} 12
+http_server_requests_seconds_sum{method="GET",status="200",uri="/api/orders"} 0.84
+http_server_requests_seconds_count{method="POST",status="500",uri="/api/orders"} 2
+http_server_requests_seconds_sum{method="POST",status="500",uri="/api/orders"} 1.5
+# HELP http_server_requests_seconds_max Duration of HTTP server request handling
+# TYPE http_server_requests_seconds_max gauge
+http_server_requests_seconds_max{method="GET",status="200",uri="/api/orders"} 0.12
+# HELP jvm_threads_live_threads The current number of live threads
+# TYPE jvm_threads_live_threads gauge
+jvm_threads_live_threads 42.0
+`
+
+const testOpenMetricsText = `# TYPE logback_events counter
+# HELP logback_events Number of log events that were enabled by the effective log level
+logback_events_total{level="error"} 3.0 # {trace_id="abc"} 1.0 1700000000.000
+logback_events_created{level="error"} 1.7E9
+# TYPE process_uptime_seconds gauge
+# UNIT process_uptime_seconds seconds
+process_uptime_seconds 120.5
+# EOF
+ignored_after_eof 1
+`
+
+func TestParsePrometheusText(t *testing.T) {
+	families, err := parsePrometheusText(testPrometheusText)
+	if err != nil {
+		t.Fatalf("parsePrometheusText() error = %v", err)
+	}
+
+	if len(families) != 3 {
+		t.Fatalf("expected 3 families, got %d", len(families))
+	}
+
+	requests := families[0]
+	if requests.Name != "http_server_requests_seconds" || requests.Type != "summary" {
+		t.Errorf("unexpected family %s of type %s", requests.Name, requests.Type)
+	}
+	if requests.Help != "Duration of HTTP server request handling" {
+		t.Errorf("unexpected help %q", requests.Help)
+	}
+	if len(requests.Samples) != 4 {
+		t.Fatalf("expected 4 samples, got %d", len(requests.Samples))
+	}
+	sample := requests.Samples[2]
+	if sample.Name != "http_server_requests_seconds_count" || sample.Value != 2 {
+		t.Errorf("unexpected sample %s = %v", sample.Name, sample.Value)
+	}
+	if sample.Labels["status"] != "500" || sample.Labels["method"] != "POST" || sample.Labels["uri"] != "/api/orders" {
+		t.Errorf("unexpected labels %v", sample.Labels)
+	}
+
+	if families[1].Name != "http_server_requests_seconds_max" || len(families[1].Samples) != 1 {
+		t.Errorf("expected the max gauge as its own family, got %s with %d samples", families[1].Name, len(families[1].Samples))
+	}
+
+	threads := families[2]
+	if len(threads.Samples) != 1 || threads.Samples[0].Value != 42 || len(threads.Samples[0].Labels) != 0 {
+		t.Errorf("unexpected samples %+v", threads.Samples)
+	}
+}
+
+func TestParseOpenMetricsText(t *testing.T) {
+	families, err := parsePrometheusText(testOpenMetricsText)
+	if err != nil {
+		t.Fatalf("parsePrometheusText() error = %v", err)
+	}
+
+	if len(families) != 2 {
+		t.Fatalf("expected 2 families, got %d", len(families))
+	}
+
+	events := families[0]
+	if events.Name != "logback_events" || events.Type != "counter" {
+		t.Errorf("unexpected family %s of type %s", events.Name, events.Type)
+	}
+	if len(events.Samples) != 2 {
+		t.Fatalf("expected 2 samples, got %d", len(events.Samples))
+	}
+	if events.Samples[0].Name != "logback_events_total" || events.Samples[0].Value != 3 {
+		t.Errorf("unexpected sample %s = %v", events.Samples[0].Name, events.Samples[0].Value)
+	}
+	if events.Samples[1].Value != 1.7e9 {
+		t.Errorf("unexpected created timestamp %v", events.Samples[1].Value)
+	}
+
+	if families[1].Unit != "seconds" {
+		t.Errorf("expected unit seconds, got %q", families[1].Unit)
+	}
+}
+
+func TestParsePrometheusSample(t *testing.T) {
+	tests := []struct {
+		name        string
+		line        string
+		wantName    string
+		wantLabels  map[string]string
+		wantValue   float64
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:       "without labels",
+			line:       "up 1",
+			wantName:   "up",
+			wantLabels: map[string]string{},
+			wantValue:  1,
+		},
+		{
+			name:       "with timestamp",
+			line:       `requests_total{path="/"} 5 1700000000000`,
+			wantName:   "requests_total",
+			wantLabels: map[string]string{"path": "/"},
+			wantValue:  5,
+		},
+		{
+			name:       "escaped label values",
+			line:       `errors_total{message="say \"hi\"\nC:\\temp",kind="io",} 1`,
+			wantName:   "errors_total",
+			wantLabels: map[string]string{"message": "say \"hi\"\nC:\\temp", "kind": "io"},
+			wantValue:  1,
+		},
+		{
+			name:       "label value with braces and commas",
+			line:       `http_requests{uri="/api/{id},x"} 2`,
+			wantName:   "http_requests",
+			wantLabels: map[string]string{"uri": "/api/{id},x"},
+			wantValue:  2,
+		},
+		{
+			name:       "positive infinity",
+			line:       `latency_bucket{le="+Inf"} +Inf`,
+			wantName:   "latency_bucket",
+			wantLabels: map[string]string{"le": "+Inf"},
+			wantValue:  math.Inf(1),
+		},
+		{
+			name:        "missing value",
+			line:        `up{job="app"}`,
+			wantErr:     true,
+			errContains: "missing value of up",
+		},
+		{
+			name:        "invalid value",
+			line:        "up one",
+			wantErr:     true,
+			errContains: "invalid value of up",
+		},
+		{
+			name:        "unquoted label value",
+			line:        "up{job=app} 1",
+			wantErr:     true,
+			errContains: "label value must be quoted",
+		},
+		{
+			name:        "unterminated label value",
+			line:        `up{job="app} 1`,
+			wantErr:     true,
+			errContains: "unterminated label value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sample, err := parsePrometheusSample(tt.line)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parsePrometheusSample() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			if sample.Name != tt.wantName {
+				t.Errorf("name = %q, want %q", sample.Name, tt.wantName)
+			}
+			if sample.Value != tt.wantValue {
+				t.Errorf("value = %v, want %v", sample.Value, tt.wantValue)
+			}
+			if len(sample.Labels) != len(tt.wantLabels) {
+				t.Errorf("labels = %v, want %v", sample.Labels, tt.wantLabels)
+			}
+			for key, value := range tt.wantLabels {
+				if sample.Labels[key] != value {
+					t.Errorf("label %s = %q, want %q", key, sample.Labels[key], value)
+				}
+			}
+		})
+	}
+}
+
+func TestActuatorClientGetPrometheus(t *testing.T) {
+	tests := []struct {
+		name            string
+		openMetrics     bool
+		mockResponse    string
+		mockStatus      int
+		mockContentType string
+		wantAccept      string
+		wantOpenMetrics bool
+		wantFamilies    int
+		wantErr         bool
+		errContains     string
+	}{
+		{
+			name:            "prometheus text format",
+			mockResponse:    testPrometheusText,
+			mockStatus:      200,
+			mockContentType: "text/plain;version=0.0.4;charset=utf-8",
+			wantAccept:      "text/plain;version=0.0.4",
+			wantFamilies:    3,
+		},
+		{
+			name:            "openmetrics negotiated",
+			openMetrics:     true,
+			mockResponse:    testOpenMetricsText,
+			mockStatus:      200,
+			mockContentType: "application/openmetrics-text;version=1.0.0;charset=utf-8",
+			wantAccept:      "application/openmetrics-text;version=1.0.0, text/plain;version=0.0.4;q=0.5",
+			wantOpenMetrics: true,
+			wantFamilies:    2,
+		},
+		{
+			name:            "openmetrics requested but not supported",
+			openMetrics:     true,
+			mockResponse:    testPrometheusText,
+			mockStatus:      200,
+			mockContentType: "text/plain;version=0.0.4;charset=utf-8",
+			wantAccept:      "application/openmetrics-text;version=1.0.0, text/plain;version=0.0.4;q=0.5",
+			wantOpenMetrics: false,
+			wantFamilies:    3,
+		},
+		{
+			name:         "invalid exposition",
+			mockResponse: "up{job=app} 1\n",
+			mockStatus:   200,
+			wantAccept:   "text/plain;version=0.0.4",
+			wantErr:      true,
+			errContains:  "failed to parse prometheus metrics: line 1",
+		},
+		{
+			name:        "404 not found",
+			mockStatus:  404,
+			wantAccept:  "text/plain;version=0.0.4",
+			wantErr:     true,
+			errContains: "Make sure the 'prometheus' endpoint is exposed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				GetWithHeadersFunc: func(path string, headers map[string]string) (*Response, error) {
+					if path != "/prometheus" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					if headers["Accept"] != tt.wantAccept {
+						t.Errorf("Accept header = %q, want %q", headers["Accept"], tt.wantAccept)
+					}
+					header := http.Header{}
+					if tt.mockContentType != "" {
+						header.Set("Content-Type", tt.mockContentType)
+					}
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+						Header:     header,
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.GetPrometheus(tt.openMetrics)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetPrometheus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				if !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			if result.OpenMetrics != tt.wantOpenMetrics {
+				t.Errorf("OpenMetrics = %v, want %v", result.OpenMetrics, tt.wantOpenMetrics)
+			}
+			if len(result.Families) != tt.wantFamilies {
+				t.Errorf("expected %d families, got %d", tt.wantFamilies, len(result.Families))
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package actuator
+
+import (
+	"fmt"
+	"net/url"
+)
+
+func (c *actuatorClient) GetQuartzJobGroups() (*QuartzJobGroupsResponse, error) {
+	var response QuartzJobGroupsResponse
+	if err := c.getAndParse("/quartz/jobs", "quartz", "failed to get quartz jobs", &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *actuatorClient) GetQuartzJob(group string, name string) (*QuartzJobDetail, error) {
+	var response QuartzJobDetail
+	if err := c.getQuartzDetail(quartzPath("jobs", group, name), "job", group, name, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *actuatorClient) GetQuartzTriggerGroups() (*QuartzTriggerGroupsResponse, error) {
+	var response QuartzTriggerGroupsResponse
+	if err := c.getAndParse("/quartz/triggers", "quartz", "failed to get quartz triggers", &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *actuatorClient) GetQuartzTrigger(group string, name string) (*QuartzTriggerDetail, error) {
+	var response QuartzTriggerDetail
+	if err := c.getQuartzDetail(quartzPath("triggers", group, name), "trigger", group, name, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// TriggerQuartzJob runs a job immediately. The endpoint supports this since Spring Boot 3.5.
+func (c *actuatorClient) TriggerQuartzJob(group string, name string) (*QuartzJobTriggerResponse, error) {
+	resp, err := c.httpClient.Post(quartzPath("jobs", group, name), quartzJobStateRequest{State: "running"})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.IsErrorStatus() {
+		switch {
+		case resp.StatusCode == 404 && c.isEndpointAccessible("/quartz"):
+			return nil, resourceNotFoundError("job", group+"."+name, resp.Status)
+		case resp.StatusCode == 405:
+			return nil, fmt.Errorf("failed to trigger job: %s\nTriggering Quartz jobs requires Spring Boot 3.5 or later", resp.Status)
+		}
+		return nil, endpointError("quartz", resp.Status, "failed to trigger job")
+	}
+
+	var response QuartzJobTriggerResponse
+	if err := parseJSON(resp.Body, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func quartzPath(kind string, group string, name string) string {
+	return "/quartz/" + kind + "/" + url.PathEscape(group) + "/" + url.PathEscape(name)
+}
+
+func (c *actuatorClient) getQuartzDetail(path string, resourceType string, group string, name string, target interface{}) error {
+	resp, err := c.httpClient.Get(path)
+	if err != nil {
+		return err
+	}
+
+	if resp.IsErrorStatus() {
+		if resp.StatusCode == 404 && c.isEndpointAccessible("/quartz") {
+			return resourceNotFoundError(resourceType, group+"."+name, resp.Status)
+		}
+		return endpointError("quartz", resp.Status, "failed to get "+resourceType)
+	}
+
+	return parseJSON(resp.Body, target)
+}
+
+type QuartzJobGroupsResponse struct {
+	Groups map[string]QuartzJobGroup `json:"groups"`
+}
+
+type QuartzJobGroup struct {
+	Jobs []string `json:"jobs"`
+}
+
+type QuartzJobDetail struct {
+	Group           string                 `json:"group"`
+	Name            string                 `json:"name"`
+	Description     string                 `json:"description,omitempty"`
+	ClassName       string                 `json:"className"`
+	Durable         bool                   `json:"durable"`
+	RequestRecovery bool                   `json:"requestRecovery"`
+	Data            map[string]interface{} `json:"data,omitempty"`
+	Triggers        []QuartzJobTrigger     `json:"triggers"`
+}
+
+type QuartzJobTrigger struct {
+	Group            string `json:"group"`
+	Name             string `json:"name"`
+	PreviousFireTime string `json:"previousFireTime,omitempty"`
+	NextFireTime     string `json:"nextFireTime,omitempty"`
+	Priority         int    `json:"priority"`
+}
+
+type QuartzTriggerGroupsResponse struct {
+	Groups map[string]QuartzTriggerGroup `json:"groups"`
+}
+
+// QuartzTriggerGroup lists the trigger names of a group by trigger type (cron, simple, ...)
+type QuartzTriggerGroup struct {
+	Paused   bool                `json:"paused"`
+	Triggers map[string][]string `json:"triggers"`
+}
+
+type QuartzTriggerDetail struct {
+	Group             string                           `json:"group"`
+	Name              string                           `json:"name"`
+	Description       string                           `json:"description,omitempty"`
+	State             string                           `json:"state"`
+	Type              string                           `json:"type"`
+	CalendarName      string                           `json:"calendarName,omitempty"`
+	StartTime         string                           `json:"startTime,omitempty"`
+	EndTime           string                           `json:"endTime,omitempty"`
+	PreviousFireTime  string                           `json:"previousFireTime,omitempty"`
+	NextFireTime      string                           `json:"nextFireTime,omitempty"`
+	FinalFireTime     string                           `json:"finalFireTime,omitempty"`
+	Priority          int                              `json:"priority"`
+	Data              map[string]interface{}           `json:"data,omitempty"`
+	Cron              *QuartzCronSchedule              `json:"cron,omitempty"`
+	Simple            *QuartzSimpleSchedule            `json:"simple,omitempty"`
+	DailyTimeInterval *QuartzDailyTimeIntervalSchedule `json:"dailyTimeInterval,omitempty"`
+	CalendarInterval  *QuartzCalendarIntervalSchedule  `json:"calendarInterval,omitempty"`
+	Custom            *QuartzCustomSchedule            `json:"custom,omitempty"`
+
+	// MisfireInstruction is not part of Spring Boot's response, but is read when an application provides it
+	MisfireInstruction *int `json:"misfireInstruction,omitempty"`
+}
+
+type QuartzCronSchedule struct {
+	Expression string `json:"expression"`
+	TimeZone   string `json:"timeZone,omitempty"`
+}
+
+type QuartzSimpleSchedule struct {
+	Interval       int64 `json:"interval"`
+	RepeatCount    int   `json:"repeatCount"`
+	TimesTriggered int   `json:"timesTriggered"`
+}
+
+type QuartzDailyTimeIntervalSchedule struct {
+	Interval       int64  `json:"interval"`
+	DaysOfWeek     []int  `json:"daysOfWeek"`
+	StartTimeOfDay string `json:"startTimeOfDay,omitempty"`
+	EndTimeOfDay   string `json:"endTimeOfDay,omitempty"`
+	RepeatCount    int    `json:"repeatCount"`
+	TimesTriggered int    `json:"timesTriggered"`
+}
+
+type QuartzCalendarIntervalSchedule struct {
+	Interval       int64  `json:"interval"`
+	TimeZone       string `json:"timeZone,omitempty"`
+	TimesTriggered int    `json:"timesTriggered"`
+}
+
+type QuartzCustomSchedule struct {
+	Trigger string `json:"trigger"`
+}
+
+type quartzJobStateRequest struct {
+	State string `json:"state"`
+}
+
+type QuartzJobTriggerResponse struct {
+	Group       string `json:"group"`
+	Name        string `json:"name"`
+	ClassName   string `json:"className"`
+	TriggerTime string `json:"triggerTime"`
+}
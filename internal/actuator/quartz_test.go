@@ -0,0 +1,312 @@
+package actuator
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestActuatorClientGetQuartzJobGroups(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  string
+		mockStatus    int
+		wantErr       bool
+		wantGroupsCnt int
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"groups": {
+					"samples": {"jobs": ["jobOne", "jobTwo"]},
+					"tests": {"jobs": ["jobThree"]}
+				}
+			}`,
+			mockStatus:    200,
+			wantErr:       false,
+			wantGroupsCnt: 2,
+		},
+		{
+			name:         "404 endpoint not found",
+			mockResponse: ``,
+			mockStatus:   404,
+			wantErr:      true,
+		},
+		{
+			name:         "malformed JSON",
+			mockResponse: `{"groups": invalid}`,
+			mockStatus:   200,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				GetFunc: func(path string) (*Response, error) {
+					if path != "/quartz/jobs" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.GetQuartzJobGroups()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetQuartzJobGroups() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && len(result.Groups) != tt.wantGroupsCnt {
+				t.Errorf("got %d groups, want %d", len(result.Groups), tt.wantGroupsCnt)
+			}
+		})
+	}
+}
+
+func TestActuatorClientGetQuartzTrigger(t *testing.T) {
+	tests := []struct {
+		name         string
+		group        string
+		trigger      string
+		mockResponse string
+		mockStatus   int
+		indexStatus  int
+		wantErr      bool
+		errContains  string
+		wantPath     string
+	}{
+		{
+			name:         "successful response",
+			group:        "samples",
+			trigger:      "every-day",
+			mockResponse: `{"group": "samples", "name": "every-day", "type": "cron", "state": "NORMAL", "cron": {"expression": "0 0 12 * * ?"}}`,
+			mockStatus:   200,
+			wantPath:     "/quartz/triggers/samples/every-day",
+		},
+		{
+			name:         "group and name are escaped",
+			group:        "my group",
+			trigger:      "a/b",
+			mockResponse: `{"group": "my group", "name": "a/b", "type": "simple"}`,
+			mockStatus:   200,
+			wantPath:     "/quartz/triggers/my%20group/a%2Fb",
+		},
+		{
+			name:        "trigger not found",
+			group:       "samples",
+			trigger:     "missing",
+			mockStatus:  404,
+			indexStatus: 200,
+			wantErr:     true,
+			errContains: "trigger 'samples.missing' not found",
+		},
+		{
+			name:        "quartz endpoint not exposed",
+			group:       "samples",
+			trigger:     "every-day",
+			mockStatus:  404,
+			indexStatus: 404,
+			wantErr:     true,
+			errContains: "endpoint is exposed",
+		},
+		{
+			name:         "malformed JSON",
+			group:        "samples",
+			trigger:      "every-day",
+			mockResponse: `{"group": invalid}`,
+			mockStatus:   200,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var capturedPath string
+			mockClient := &MockHTTPClient{
+				GetFunc: func(path string) (*Response, error) {
+					if path == "/quartz" {
+						return &Response{StatusCode: tt.indexStatus, Status: strconv.Itoa(tt.indexStatus)}, nil
+					}
+					capturedPath = path
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.GetQuartzTrigger(tt.group, tt.trigger)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetQuartzTrigger() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				if tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			if capturedPath != tt.wantPath {
+				t.Errorf("path = %s, want %s", capturedPath, tt.wantPath)
+			}
+			if result.Name != tt.trigger {
+				t.Errorf("name = %s, want %s", result.Name, tt.trigger)
+			}
+		})
+	}
+}
+
+func TestActuatorClientTriggerQuartzJob(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockResponse string
+		mockStatus   int
+		indexStatus  int
+		wantErr      bool
+		errContains  string
+	}{
+		{
+			name:         "successful trigger",
+			mockResponse: `{"group": "samples", "name": "jobOne", "className": "org.example.SampleJob", "triggerTime": "2025-05-20T10:00:00.000Z"}`,
+			mockStatus:   200,
+		},
+		{
+			name:        "job not found",
+			mockStatus:  404,
+			indexStatus: 200,
+			wantErr:     true,
+			errContains: "job 'samples.jobOne' not found",
+		},
+		{
+			name:        "triggering not supported",
+			mockStatus:  405,
+			wantErr:     true,
+			errContains: "Spring Boot 3.5",
+		},
+		{
+			name:        "500 internal server error",
+			mockStatus:  500,
+			wantErr:     true,
+			errContains: "failed to trigger job",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var capturedPath string
+			var capturedBody interface{}
+			mockClient := &MockHTTPClient{
+				GetFunc: func(path string) (*Response, error) {
+					return &Response{StatusCode: tt.indexStatus, Status: strconv.Itoa(tt.indexStatus)}, nil
+				},
+				PostFunc: func(path string, body interface{}) (*Response, error) {
+					capturedPath = path
+					capturedBody = body
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.TriggerQuartzJob("samples", "jobOne")
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TriggerQuartzJob() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				if tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			if capturedPath != "/quartz/jobs/samples/jobOne" {
+				t.Errorf("path = %s, want /quartz/jobs/samples/jobOne", capturedPath)
+			}
+			if request, ok := capturedBody.(quartzJobStateRequest); !ok || request.State != "running" {
+				t.Errorf("body = %#v, want state running", capturedBody)
+			}
+			if result.ClassName != "org.example.SampleJob" {
+				t.Errorf("className = %s, want org.example.SampleJob", result.ClassName)
+			}
+		})
+	}
+}
+
+func TestQuartzTriggerDetailParsing(t *testing.T) {
+	response := `{
+		"group": "samples",
+		"name": "example",
+		"description": "Example trigger",
+		"state": "NORMAL",
+		"type": "dailyTimeInterval",
+		"calendarName": "bankHolidays",
+		"startTime": "2020-12-04T12:00:00.000+00:00",
+		"previousFireTime": "2020-12-04T14:00:00.000+00:00",
+		"nextFireTime": "2020-12-04T15:00:00.000+00:00",
+		"priority": 5,
+		"data": {},
+		"dailyTimeInterval": {
+			"interval": 3600000,
+			"daysOfWeek": [3, 5],
+			"startTimeOfDay": "09:00:00",
+			"endTimeOfDay": "18:00:00",
+			"repeatCount": -1,
+			"timesTriggered": 2
+		}
+	}`
+
+	mockClient := &MockHTTPClient{
+		GetFunc: func(path string) (*Response, error) {
+			return &Response{Body: []byte(response), StatusCode: 200, Status: "200 OK"}, nil
+		},
+	}
+
+	client := &actuatorClient{httpClient: mockClient}
+	result, err := client.GetQuartzTrigger("samples", "example")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.State != "NORMAL" || result.Type != "dailyTimeInterval" {
+		t.Errorf("state/type = %s/%s, want NORMAL/dailyTimeInterval", result.State, result.Type)
+	}
+	if result.CalendarName != "bankHolidays" {
+		t.Errorf("calendarName = %s, want bankHolidays", result.CalendarName)
+	}
+	if result.Priority != 5 {
+		t.Errorf("priority = %d, want 5", result.Priority)
+	}
+	if result.Cron != nil || result.Simple != nil {
+		t.Error("expected only the dailyTimeInterval schedule to be set")
+	}
+	if result.DailyTimeInterval == nil {
+		t.Fatal("expected dailyTimeInterval schedule")
+	}
+	if result.DailyTimeInterval.Interval != 3600000 {
+		t.Errorf("interval = %d, want 3600000", result.DailyTimeInterval.Interval)
+	}
+	if len(result.DailyTimeInterval.DaysOfWeek) != 2 {
+		t.Errorf("got %d days of week, want 2", len(result.DailyTimeInterval.DaysOfWeek))
+	}
+	if result.MisfireInstruction != nil {
+		t.Errorf("misfireInstruction = %d, want nil", *result.MisfireInstruction)
+	}
+}
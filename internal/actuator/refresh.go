@@ -0,0 +1,36 @@
+package actuator
+
+// Refresh reloads the configuration of a Spring Cloud application and returns the keys of the changed properties
+func (c *actuatorClient) Refresh() ([]string, error) {
+	resp, err := c.httpClient.Post("/refresh", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.IsErrorStatus() {
+		return nil, endpointError("refresh", resp.Status, "failed to refresh configuration")
+	}
+
+	var changedKeys []string
+	if len(resp.Body) > 0 {
+		if err := parseJSON(resp.Body, &changedKeys); err != nil {
+			return nil, err
+		}
+	}
+	return changedKeys, nil
+}
+
+// BusRefresh publishes a refresh event to the Spring Cloud Bus, which refreshes every application instance
+// connected to the bus. The endpoint does not report the changed keys.
+func (c *actuatorClient) BusRefresh() error {
+	resp, err := c.httpClient.Post("/busrefresh", nil)
+	if err != nil {
+		return err
+	}
+
+	if resp.IsErrorStatus() {
+		return endpointError("busrefresh", resp.Status, "failed to publish refresh event")
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package actuator
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestActuatorClientRefresh(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockResponse string
+		mockStatus   int
+		wantErr      bool
+		wantKeys     []string
+	}{
+		{
+			name:         "changed keys",
+			mockResponse: `["config.client.version", "app.greeting"]`,
+			mockStatus:   200,
+			wantKeys:     []string{"config.client.version", "app.greeting"},
+		},
+		{
+			name:         "nothing changed",
+			mockResponse: `[]`,
+			mockStatus:   200,
+		},
+		{
+			name:         "404 endpoint not found",
+			mockResponse: ``,
+			mockStatus:   404,
+			wantErr:      true,
+		},
+		{
+			name:         "malformed JSON",
+			mockResponse: `[invalid]`,
+			mockStatus:   200,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				PostFunc: func(path string, body interface{}) (*Response, error) {
+					if path != "/refresh" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			keys, err := client.Refresh()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Refresh() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if len(keys) != len(tt.wantKeys) {
+				t.Fatalf("got %d keys, want %d", len(keys), len(tt.wantKeys))
+			}
+			for i, key := range tt.wantKeys {
+				if keys[i] != key {
+					t.Errorf("key[%d] = %s, want %s", i, keys[i], key)
+				}
+			}
+		})
+	}
+}
+
+func TestActuatorClientBusRefresh(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockStatus int
+		wantErr    bool
+	}{
+		{name: "event published", mockStatus: 204},
+		{name: "404 endpoint not found", mockStatus: 404, wantErr: true},
+		{name: "500 internal server error", mockStatus: 500, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				PostFunc: func(path string, body interface{}) (*Response, error) {
+					if path != "/busrefresh" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					return &Response{StatusCode: tt.mockStatus, Status: strconv.Itoa(tt.mockStatus)}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			err := client.BusRefresh()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BusRefresh() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
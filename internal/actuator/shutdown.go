@@ -0,0 +1,24 @@
+package actuator
+
+func (c *actuatorClient) Shutdown() (*ShutdownResponse, error) {
+	resp, err := c.httpClient.Post("/shutdown", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.IsErrorStatus() {
+		return nil, endpointError("shutdown", resp.Status, "failed to shut down application")
+	}
+
+	var response ShutdownResponse
+	if len(resp.Body) > 0 {
+		if err := parseJSON(resp.Body, &response); err != nil {
+			return nil, err
+		}
+	}
+	return &response, nil
+}
+
+type ShutdownResponse struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,77 @@
+package actuator
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestActuatorClientShutdown(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockResponse string
+		mockStatus   int
+		wantErr      bool
+		errContains  string
+		wantMessage  string
+	}{
+		{
+			name:         "successful shutdown",
+			mockResponse: `{"message": "Shutting down, bye..."}`,
+			mockStatus:   200,
+			wantMessage:  "Shutting down, bye...",
+		},
+		{
+			name:       "empty response",
+			mockStatus: 200,
+		},
+		{
+			name:        "shutdown endpoint not enabled",
+			mockStatus:  404,
+			wantErr:     true,
+			errContains: "Make sure the 'shutdown' endpoint is exposed",
+		},
+		{
+			name:         "malformed JSON",
+			mockResponse: `{"message": invalid}`,
+			mockStatus:   200,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				PostFunc: func(path string, body interface{}) (*Response, error) {
+					if path != "/shutdown" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.Shutdown()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Shutdown() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				if tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			if result.Message != tt.wantMessage {
+				t.Errorf("message = %q, want %q", result.Message, tt.wantMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package actuator
+
+// GetStartup returns the startup steps recorded by a BufferingApplicationStartup.
+// Unlike POST, a GET request does not drain the buffer.
+func (c *actuatorClient) GetStartup() (*StartupResponse, error) {
+	var response StartupResponse
+	if err := c.getAndParse("/startup", "startup", "failed to get startup steps", &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+type StartupResponse struct {
+	SpringBootVersion string          `json:"springBootVersion"`
+	Timeline          StartupTimeline `json:"timeline"`
+}
+
+type StartupTimeline struct {
+	StartTime string         `json:"startTime"`
+	Events    []StartupEvent `json:"events"`
+}
+
+type StartupEvent struct {
+	StartTime   string      `json:"startTime"`
+	EndTime     string      `json:"endTime"`
+	Duration    Duration    `json:"duration"`
+	StartupStep StartupStep `json:"startupStep"`
+}
+
+type StartupStep struct {
+	Name     string           `json:"name"`
+	ID       int64            `json:"id"`
+	ParentID *int64           `json:"parentId,omitempty"`
+	Tags     []StartupStepTag `json:"tags"`
+}
+
+type StartupStepTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,133 @@
+package actuator
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestActuatorClientGetStartup(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockResponse string
+		mockStatus   int
+		wantErr      bool
+		wantEvents   int
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"springBootVersion": "3.5.8",
+				"timeline": {
+					"startTime": "2024-01-15T10:30:00.000Z",
+					"events": [
+						{
+							"endTime": "2024-01-15T10:30:00.500Z",
+							"duration": "PT0.5S",
+							"startTime": "2024-01-15T10:30:00.000Z",
+							"startupStep": {"name": "spring.boot.application.starting", "id": 0, "tags": []}
+						}
+					]
+				}
+			}`,
+			mockStatus: 200,
+			wantErr:    false,
+			wantEvents: 1,
+		},
+		{
+			name:         "404 endpoint not found",
+			mockResponse: ``,
+			mockStatus:   404,
+			wantErr:      true,
+		},
+		{
+			name:         "malformed JSON",
+			mockResponse: `{"timeline": invalid}`,
+			mockStatus:   200,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				GetFunc: func(path string) (*Response, error) {
+					if path != "/startup" {
+						t.Errorf("unexpected path: %s", path)
+					}
+					return &Response{
+						Body:       []byte(tt.mockResponse),
+						StatusCode: tt.mockStatus,
+						Status:     strconv.Itoa(tt.mockStatus),
+					}, nil
+				},
+			}
+
+			client := &actuatorClient{httpClient: mockClient}
+			result, err := client.GetStartup()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetStartup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && len(result.Timeline.Events) != tt.wantEvents {
+				t.Errorf("got %d events, want %d", len(result.Timeline.Events), tt.wantEvents)
+			}
+		})
+	}
+}
+
+func TestStartupResponseParsing(t *testing.T) {
+	response := `{
+		"springBootVersion": "3.5.8",
+		"timeline": {
+			"startTime": "2024-01-15T10:30:00.000Z",
+			"events": [
+				{
+					"endTime": "2024-01-15T10:30:01.200Z",
+					"duration": "PT0.0154S",
+					"startTime": "2024-01-15T10:30:01.185Z",
+					"startupStep": {
+						"name": "spring.beans.instantiate",
+						"id": 42,
+						"tags": [{"key": "beanName", "value": "orderService"}],
+						"parentId": 7
+					}
+				}
+			]
+		}
+	}`
+
+	mockClient := &MockHTTPClient{
+		GetFunc: func(path string) (*Response, error) {
+			return &Response{Body: []byte(response), StatusCode: 200, Status: "200"}, nil
+		},
+	}
+
+	client := &actuatorClient{httpClient: mockClient}
+	result, err := client.GetStartup()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.SpringBootVersion != "3.5.8" {
+		t.Errorf("expected version '3.5.8', got '%s'", result.SpringBootVersion)
+	}
+
+	event := result.Timeline.Events[0]
+	if event.Duration.Duration != 15400*time.Microsecond {
+		t.Errorf("expected duration 15.4ms, got %v", event.Duration.Duration)
+	}
+
+	step := event.StartupStep
+	if step.Name != "spring.beans.instantiate" || step.ID != 42 {
+		t.Errorf("unexpected step: %+v", step)
+	}
+	if step.ParentID == nil || *step.ParentID != 7 {
+		t.Errorf("expected parentId 7, got %v", step.ParentID)
+	}
+	if len(step.Tags) != 1 || step.Tags[0].Key != "beanName" || step.Tags[0].Value != "orderService" {
+		t.Errorf("unexpected tags: %+v", step.Tags)
+	}
+}
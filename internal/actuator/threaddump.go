@@ -1,5 +1,7 @@
 package actuator
 
+import "fmt"
+
 func (c *actuatorClient) GetThreadDump() (*ThreadDumpResponse, error) {
 	var threadDumpResponse ThreadDumpResponse
 	if err := c.getAndParse("/threaddump", "threaddump", "failed to get thread dump", &threadDumpResponse); err != nil {
@@ -20,14 +22,35 @@ type Thread struct {
 	BlockedTime         int64         `json:"blockedTime"`
 	WaitedCount         int64         `json:"waitedCount"`
 	WaitedTime          int64         `json:"waitedTime"`
+	LockName            string        `json:"lockName"`
 	LockOwnerId         int64         `json:"lockOwnerId"`
+	LockOwnerName       string        `json:"lockOwnerName"`
 	Daemon              bool          `json:"daemon"`
 	InNative            bool          `json:"inNative"`
 	Suspended           bool          `json:"suspended"`
 	Priority            int           `json:"priority"`
 	StackTrace          []StackFrame  `json:"stackTrace"`
-	LockedMonitors      []interface{} `json:"lockedMonitors"`
-	LockedSynchronizers []interface{} `json:"lockedSynchronizers"`
+	LockInfo            *LockInfo     `json:"lockInfo"`
+	LockedMonitors      []MonitorInfo `json:"lockedMonitors"`
+	LockedSynchronizers []LockInfo    `json:"lockedSynchronizers"`
+}
+
+// LockInfo identifies a monitor or an ownable synchronizer, like a ReentrantLock
+type LockInfo struct {
+	ClassName        string `json:"className"`
+	IdentityHashCode int64  `json:"identityHashCode"`
+}
+
+// String returns the lock in the format used by jstack, e.g. java.lang.Object@1b2c3d4e
+func (l LockInfo) String() string {
+	return fmt.Sprintf("%s@%x", l.ClassName, l.IdentityHashCode)
+}
+
+// MonitorInfo is a monitor locked by a thread, including the stack frame that entered the synchronized block
+type MonitorInfo struct {
+	LockInfo
+	LockedStackDepth int         `json:"lockedStackDepth"`
+	LockedStackFrame *StackFrame `json:"lockedStackFrame"`
 }
 
 type StackFrame struct {
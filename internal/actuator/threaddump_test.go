@@ -267,6 +267,55 @@ func TestThreadDumpResponseParsing(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "thread with locks",
+			response: `{
+				"threads": [
+					{
+						"threadName": "worker-1",
+						"threadId": 31,
+						"threadState": "BLOCKED",
+						"lockName": "java.lang.Object@1b2c3d4e",
+						"lockOwnerId": 32,
+						"lockOwnerName": "worker-2",
+						"lockInfo": {"className": "java.lang.Object", "identityHashCode": 455884110},
+						"stackTrace": [
+							{"className": "com.example.Transfer", "methodName": "debit", "fileName": "Transfer.java", "lineNumber": 42, "nativeMethod": false}
+						],
+						"lockedMonitors": [
+							{
+								"className": "com.example.Account",
+								"identityHashCode": 255,
+								"lockedStackDepth": 0,
+								"lockedStackFrame": {"className": "com.example.Transfer", "methodName": "debit", "fileName": "Transfer.java", "lineNumber": 42, "nativeMethod": false}
+							}
+						],
+						"lockedSynchronizers": [
+							{"className": "java.util.concurrent.locks.ReentrantLock$NonfairSync", "identityHashCode": 16}
+						]
+					}
+				]
+			}`,
+			validate: func(t *testing.T, resp *ThreadDumpResponse) {
+				thread := resp.Threads[0]
+				if thread.LockOwnerId != 32 || thread.LockOwnerName != "worker-2" {
+					t.Errorf("unexpected lock owner %d %q", thread.LockOwnerId, thread.LockOwnerName)
+				}
+				if thread.LockInfo == nil || thread.LockInfo.String() != "java.lang.Object@1b2c3d4e" {
+					t.Errorf("unexpected lock info %+v", thread.LockInfo)
+				}
+				if len(thread.LockedMonitors) != 1 {
+					t.Fatalf("expected 1 locked monitor, got %d", len(thread.LockedMonitors))
+				}
+				monitor := thread.LockedMonitors[0]
+				if monitor.String() != "com.example.Account@ff" || monitor.LockedStackFrame == nil || monitor.LockedStackFrame.MethodName != "debit" {
+					t.Errorf("unexpected locked monitor %+v", monitor)
+				}
+				if len(thread.LockedSynchronizers) != 1 || thread.LockedSynchronizers[0].String() != "java.util.concurrent.locks.ReentrantLock$NonfairSync@10" {
+					t.Errorf("unexpected locked synchronizers %+v", thread.LockedSynchronizers)
+				}
+			},
+		},
 		{
 			name: "many threads",
 			response: `{
@@ -79,9 +79,24 @@ func AddCommands(rootCmd *cobra.Command) {
 	rootCmd.AddCommand(NewInfoCommand(configFlags, FlagsPodResolver))
 	rootCmd.AddCommand(NewHealthCommand(configFlags, FlagsPodResolver))
 	rootCmd.AddCommand(NewMetricsCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewTopCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewPrometheusCommand(configFlags, FlagsPodResolver))
 	rootCmd.AddCommand(NewEnvCommand(configFlags, FlagsPodResolver))
 	rootCmd.AddCommand(NewThreadDumpCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewHeapDumpCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewLogFileCommand(configFlags, FlagsPodResolver))
 	rootCmd.AddCommand(NewBeansCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewCachesCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewMappingsCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewConditionsCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewConfigPropsCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewHTTPExchangesCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewHTTPTraceCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewStartupCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewMigrationsCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewQuartzCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewRefreshCommand(configFlags, FlagsPodResolver))
+	rootCmd.AddCommand(NewShutdownCommand(configFlags, FlagsPodResolver))
 	rootCmd.AddCommand(NewRawCommand(configFlags, FlagsPodResolver))
 	rootCmd.AddCommand(NewVersionCommand())
 }
@@ -178,6 +193,7 @@ type baseOperations struct {
 	k8sCliFlags           *genericclioptions.ConfigFlags
 	podResolver           PodResolver
 	actuatorClientFactory *ActuatorClientFactory
+	k8sClient             k8s.Client
 	pods                  []string
 }
 
@@ -194,6 +210,7 @@ func (b *baseOperations) complete(cmd *cobra.Command) error {
 	}
 	b.pods = pods
 
+	b.k8sClient = connection
 	b.actuatorClientFactory = NewActuatorClientFactory(connection, cmd)
 
 	return nil
@@ -52,6 +52,14 @@ func (m *mockK8sClient) GetDeploymentPods(_ context.Context, namespace, deployme
 	return []string{}, nil
 }
 
+func (m *mockK8sClient) GetPodDeployment(_ context.Context, _, _ string) (string, error) {
+	return "", nil
+}
+
+func (m *mockK8sClient) WaitForReplacementPod(_ context.Context, _, _ string, _ *corev1.Pod, _ []string) (string, error) {
+	return "", nil
+}
+
 func (m *mockK8sClient) Clientset() kubernetes.Interface {
 	return fake.NewClientset()
 }
@@ -493,6 +501,14 @@ func (f *fakeK8sClientWrapper) GetDeploymentPods(ctx context.Context, namespace,
 	return podNames, nil
 }
 
+func (f *fakeK8sClientWrapper) GetPodDeployment(_ context.Context, _, _ string) (string, error) {
+	return "", nil
+}
+
+func (f *fakeK8sClientWrapper) WaitForReplacementPod(_ context.Context, _, _ string, _ *corev1.Pod, _ []string) (string, error) {
+	return "", nil
+}
+
 func (f *fakeK8sClientWrapper) Clientset() kubernetes.Interface {
 	return f.clientset.(*fake.Clientset)
 }
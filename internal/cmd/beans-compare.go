@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+const (
+	beanDriftMissing = "missing"
+	beanDriftContext = "context"
+	beanDriftType    = "type"
+	beanDriftScope   = "scope"
+)
+
+// runCompare loads the beans of every pod and shows the beans that are not the same on all of them
+func (o *beansCommandOperations) runCompare(ctx context.Context) error {
+	var pods []string
+	var responses []*actuator.BeansResponse
+	failed := 0
+
+	for _, pod := range o.pods {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		beansResponse, err := o.fetchBeans(ctx, pod)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %s: %v\n", pod, err)
+			failed++
+			continue
+		}
+		pods = append(pods, pod)
+		responses = append(responses, beansResponse)
+	}
+
+	if len(responses) > 1 {
+		beans, drifts := compareBeans(responses, o.beanFilter)
+		fmt.Printf("Compared %d beans on %d pods\n", beans, len(pods))
+		fmt.Println()
+		displayBeanDrifts(pods, drifts, o.output == OutputFormatWide)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("compare beans failed on %d pod(s)", failed)
+	}
+	return nil
+}
+
+func (o *beansCommandOperations) fetchBeans(ctx context.Context, podName string) (*actuator.BeansResponse, error) {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBeans()
+}
+
+// beanDrift is a bean that is missing on some pods or differs in one attribute between them
+type beanDrift struct {
+	name       string
+	difference string
+	// beans holds the bean of each pod, in the order of the pods, or nil if the pod does not have it
+	beans []*beanRef
+}
+
+// compareBeans matches the beans of the pods by name and returns the number of compared beans together with
+// the beans that differ. A bean that is missing on some pods is only reported as missing. The filter is
+// applied after matching, so a bean is compared if it matches on any pod, e.g. a bean whose type only
+// matches --type on some pods is reported as a type difference.
+func compareBeans(responses []*actuator.BeansResponse, filter beanFilter) (int, []beanDrift) {
+	beansByName := make(map[string][]*beanRef)
+	for i, beansResponse := range responses {
+		for contextName, appCtx := range beansResponse.Contexts {
+			for beanName, bean := range appCtx.Beans {
+				refs, exists := beansByName[beanName]
+				if !exists {
+					refs = make([]*beanRef, len(responses))
+					beansByName[beanName] = refs
+				}
+				// A bean defined in several contexts of the same pod is compared by its first context that
+				// matches the filter
+				ref := &beanRef{name: beanName, context: contextName, bean: bean}
+				if refs[i] == nil {
+					refs[i] = ref
+					continue
+				}
+				matches, previousMatches := filter.matches(contextName, beanName, bean), filter.matches(refs[i].context, beanName, refs[i].bean)
+				if (matches && !previousMatches) || (matches == previousMatches && contextName < refs[i].context) {
+					refs[i] = ref
+				}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(beansByName))
+	for name, refs := range beansByName {
+		if slices.ContainsFunc(refs, func(ref *beanRef) bool {
+			return ref != nil && filter.matches(ref.context, ref.name, ref.bean)
+		}) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	attributes := []struct {
+		difference string
+		value      func(ref *beanRef) string
+	}{
+		{beanDriftContext, func(ref *beanRef) string { return ref.context }},
+		{beanDriftType, func(ref *beanRef) string { return ref.bean.Type }},
+		{beanDriftScope, func(ref *beanRef) string { return beanScope(ref.bean) }},
+	}
+
+	var drifts []beanDrift
+	for _, name := range names {
+		refs := beansByName[name]
+		if slices.Contains(refs, nil) {
+			drifts = append(drifts, beanDrift{name: name, difference: beanDriftMissing, beans: refs})
+			continue
+		}
+		for _, attribute := range attributes {
+			for _, ref := range refs[1:] {
+				if attribute.value(ref) != attribute.value(refs[0]) {
+					drifts = append(drifts, beanDrift{name: name, difference: attribute.difference, beans: refs})
+					break
+				}
+			}
+		}
+	}
+
+	return len(names), drifts
+}
+
+// displayBeanDrifts shows one row per difference with a column for each pod. For missing beans and type
+// differences the column holds the type, otherwise the differing value.
+func displayBeanDrifts(pods []string, drifts []beanDrift, wideMode bool) {
+	if len(drifts) == 0 {
+		fmt.Println("No differences found.")
+		return
+	}
+
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	_, _ = fmt.Fprintf(w, "BEAN\tDIFFERENCE\t%s\n", strings.Join(pods, "\t"))
+	for _, drift := range drifts {
+		columns := []string{smartTruncate(drift.name, maxBeanNameLength), drift.difference}
+		for _, ref := range drift.beans {
+			columns = append(columns, beanDriftValue(drift.difference, ref, wideMode))
+		}
+		_, _ = fmt.Fprintln(w, strings.Join(columns, "\t"))
+	}
+}
+
+func beanDriftValue(difference string, ref *beanRef, wideMode bool) string {
+	switch {
+	case ref == nil:
+		return "-"
+	case difference == beanDriftContext:
+		return ref.context
+	case difference == beanDriftScope:
+		return beanScope(ref.bean)
+	case wideMode:
+		return ref.bean.Type
+	default:
+		return shortenType(ref.bean.Type, maxBeanTypeLength)
+	}
+}
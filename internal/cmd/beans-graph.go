@@ -0,0 +1,376 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+const (
+	graphFormatDot     = "dot"
+	graphFormatMermaid = "mermaid"
+)
+
+var validGraphFormats = []string{graphFormatDot, graphFormatMermaid}
+
+// beanGraph is the dependency graph of the beans, with an edge from each bean to the beans it depends on.
+// A bean name that is defined in several application contexts is qualified with the context, e.g.
+// "orderService@bootstrap", so that every definition has its own node.
+type beanGraph struct {
+	nodes []string
+	types map[string]string
+	edges map[string][]string
+	root  string
+	// qualified holds the nodes of the graph that had to be qualified with their context
+	qualified []string
+}
+
+// buildBeanGraph returns the graph of all beans, or the part reachable from root within depth steps if a
+// root is given. With dependents, the graph is traversed against the direction of the edges, i.e. it
+// contains the beans that depend on the root. A depth of 0 means no limit.
+//
+// Dependencies are resolved the way Spring does, in the context of the dependent bean first and then in its
+// parents, so a bean in a parent context that is shadowed by a child context only has the dependents that
+// actually see it.
+func buildBeanGraph(beansResponse *actuator.BeansResponse, root string, depth int, dependents bool) (*beanGraph, error) {
+	contextsByName := make(map[string][]string)
+	for contextName, appCtx := range beansResponse.Contexts {
+		for beanName := range appCtx.Beans {
+			contextsByName[beanName] = append(contextsByName[beanName], contextName)
+		}
+	}
+	qualified := make(map[string]bool)
+	nodeName := func(contextName, beanName string) string {
+		if len(contextsByName[beanName]) > 1 {
+			node := beanName + "@" + contextName
+			qualified[node] = true
+			return node
+		}
+		return beanName
+	}
+
+	types := make(map[string]string)
+	dependencies := make(map[string][]string)
+	var missing []string
+	for contextName, appCtx := range beansResponse.Contexts {
+		contextChain := contextHierarchy(beansResponse, contextName)
+		for beanName, bean := range appCtx.Beans {
+			node := nodeName(contextName, beanName)
+			types[node] = bean.Type
+			for _, dependency := range bean.Dependencies {
+				if resolvedContext, resolvedName, found := resolveBean(beansResponse, contextChain, dependency); found {
+					dependency = nodeName(resolvedContext, resolvedName)
+				} else {
+					missing = append(missing, dependency)
+				}
+				dependencies[node] = append(dependencies[node], dependency)
+			}
+		}
+	}
+	// Dependencies that are not beans, like the environment, only appear as targets
+	for _, dependency := range missing {
+		if _, exists := types[dependency]; !exists {
+			types[dependency] = ""
+		}
+	}
+
+	dependentsOf := make(map[string][]string)
+	for node, deps := range dependencies {
+		for _, dependency := range deps {
+			dependentsOf[dependency] = append(dependentsOf[dependency], node)
+		}
+	}
+
+	graph := &beanGraph{types: types, edges: make(map[string][]string), root: root}
+	addEdge := func(from, to string) {
+		graph.edges[from] = append(graph.edges[from], to)
+	}
+
+	if root == "" {
+		for node := range types {
+			graph.nodes = append(graph.nodes, node)
+			for _, dependency := range dependencies[node] {
+				addEdge(node, dependency)
+			}
+		}
+	} else {
+		if _, exists := types[root]; !exists || types[root] == "" {
+			if contexts := contextsByName[root]; len(contexts) > 1 {
+				sort.Strings(contexts)
+				var candidates []string
+				for _, contextName := range contexts {
+					candidates = append(candidates, nodeName(contextName, root))
+				}
+				return nil, fmt.Errorf("bean '%s' is defined in several contexts, use one of: %s", root, strings.Join(candidates, ", "))
+			}
+			return nil, fmt.Errorf("bean '%s' not found", root)
+		}
+
+		level := map[string]int{root: 0}
+		queue := []string{root}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			graph.nodes = append(graph.nodes, current)
+			if depth > 0 && level[current] >= depth {
+				continue
+			}
+
+			neighbors := dependencies[current]
+			if dependents {
+				neighbors = dependentsOf[current]
+			}
+			for _, neighbor := range neighbors {
+				if _, seen := level[neighbor]; !seen {
+					level[neighbor] = level[current] + 1
+					queue = append(queue, neighbor)
+				}
+			}
+		}
+
+		// The walk stops at the depth limit, so the edges are added afterwards to also include those
+		// between beans at the limit, like the way back of a cycle
+		for _, node := range graph.nodes {
+			for _, dependency := range dependencies[node] {
+				if _, inGraph := level[dependency]; inGraph {
+					addEdge(node, dependency)
+				}
+			}
+		}
+	}
+
+	sort.Strings(graph.nodes)
+	for from := range graph.edges {
+		sort.Strings(graph.edges[from])
+		graph.edges[from] = compactStrings(graph.edges[from])
+	}
+	for _, node := range graph.nodes {
+		if qualified[node] {
+			graph.qualified = append(graph.qualified, node)
+		}
+	}
+	return graph, nil
+}
+
+func compactStrings(sorted []string) []string {
+	result := sorted[:0]
+	for i, s := range sorted {
+		if i == 0 || s != sorted[i-1] {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// cycles returns one dependency cycle for every group of beans that depend on each other, each starting
+// and ending with the same bean
+func (g *beanGraph) cycles() [][]string {
+	index := make(map[string]int)
+	lowLink := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	var cycles [][]string
+	counter := 0
+
+	// Tarjan's algorithm finds the strongly connected components of the graph
+	var connect func(node string)
+	connect = func(node string) {
+		index[node] = counter
+		lowLink[node] = counter
+		counter++
+		stack = append(stack, node)
+		onStack[node] = true
+
+		for _, next := range g.edges[node] {
+			if _, visited := index[next]; !visited {
+				connect(next)
+				lowLink[node] = min(lowLink[node], lowLink[next])
+			} else if onStack[next] {
+				lowLink[node] = min(lowLink[node], index[next])
+			}
+		}
+
+		if lowLink[node] != index[node] {
+			return
+		}
+		component := make(map[string]bool)
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+			component[last] = true
+			if last == node {
+				break
+			}
+		}
+		if cycle := g.cycleWithin(component); cycle != nil {
+			cycles = append(cycles, cycle)
+		}
+	}
+
+	for _, node := range g.nodes {
+		if _, visited := index[node]; !visited {
+			connect(node)
+		}
+	}
+
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i][0] < cycles[j][0]
+	})
+	return cycles
+}
+
+// cycleWithin returns the shortest cycle through the alphabetically first bean of a strongly connected
+// component, or nil if the component is a single bean without a dependency on itself
+func (g *beanGraph) cycleWithin(component map[string]bool) []string {
+	members := make([]string, 0, len(component))
+	for member := range component {
+		members = append(members, member)
+	}
+	sort.Strings(members)
+	start := members[0]
+
+	previous := map[string]string{}
+	queue := []string{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range g.edges[current] {
+			if next == start {
+				cycle := []string{start}
+				for node := current; node != start; node = previous[node] {
+					cycle = append(cycle, node)
+				}
+				// The path was collected backwards from the last bean of the cycle
+				for i, j := 1, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				return append(cycle, start)
+			}
+			if _, seen := previous[next]; !seen && component[next] {
+				previous[next] = current
+				queue = append(queue, next)
+			}
+		}
+	}
+	return nil
+}
+
+func cycleEdges(cycles [][]string) map[[2]string]bool {
+	edges := make(map[[2]string]bool)
+	for _, cycle := range cycles {
+		for i := 0; i < len(cycle)-1; i++ {
+			edges[[2]string{cycle[i], cycle[i+1]}] = true
+		}
+	}
+	return edges
+}
+
+func (g *beanGraph) label(node string) string {
+	if g.types[node] == "" {
+		return node
+	}
+	return node + "\n" + shortenType(g.types[node], maxBeanTypeLength)
+}
+
+// displayBeanGraph writes the graph to stdout and reports the dependency cycles on stderr, so that the
+// output can be piped into Graphviz or Mermaid directly
+func displayBeanGraph(graph *beanGraph, format string) {
+	cycles := graph.cycles()
+
+	switch format {
+	case graphFormatMermaid:
+		writeMermaidGraph(os.Stdout, graph, cycles)
+	default:
+		writeDotGraph(os.Stdout, graph, cycles)
+	}
+
+	if len(graph.qualified) > 0 {
+		_, _ = fmt.Fprintf(os.Stderr, "Warning: beans defined in several contexts are shown once per context: %s\n",
+			strings.Join(graph.qualified, ", "))
+	}
+	for _, cycle := range cycles {
+		_, _ = fmt.Fprintf(os.Stderr, "Dependency cycle: %s\n", strings.Join(cycle, " -> "))
+	}
+}
+
+func writeDotGraph(w io.Writer, graph *beanGraph, cycles [][]string) {
+	inCycle := cycleEdges(cycles)
+
+	_, _ = fmt.Fprintln(w, "digraph beans {")
+	_, _ = fmt.Fprintln(w, "  rankdir=LR;")
+	_, _ = fmt.Fprintln(w, "  node [shape=box];")
+	for _, cycle := range cycles {
+		_, _ = fmt.Fprintf(w, "  // cycle: %s\n", strings.Join(cycle, " -> "))
+	}
+	for _, node := range graph.nodes {
+		attributes := fmt.Sprintf("label=%s", dotQuote(graph.label(node)))
+		if node == graph.root {
+			attributes += ", style=bold"
+		}
+		_, _ = fmt.Fprintf(w, "  %s [%s];\n", dotQuote(node), attributes)
+	}
+	for _, from := range graph.nodes {
+		for _, to := range graph.edges[from] {
+			if inCycle[[2]string{from, to}] {
+				_, _ = fmt.Fprintf(w, "  %s -> %s [color=red];\n", dotQuote(from), dotQuote(to))
+			} else {
+				_, _ = fmt.Fprintf(w, "  %s -> %s;\n", dotQuote(from), dotQuote(to))
+			}
+		}
+	}
+	_, _ = fmt.Fprintln(w, "}")
+}
+
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+func writeMermaidGraph(w io.Writer, graph *beanGraph, cycles [][]string) {
+	inCycle := cycleEdges(cycles)
+
+	// Bean names may contain characters that Mermaid does not accept in node IDs, so the nodes are numbered
+	ids := make(map[string]string, len(graph.nodes))
+	for i, node := range graph.nodes {
+		ids[node] = fmt.Sprintf("n%d", i)
+	}
+
+	_, _ = fmt.Fprintln(w, "graph LR")
+	for _, cycle := range cycles {
+		_, _ = fmt.Fprintf(w, "  %%%% cycle: %s\n", strings.Join(cycle, " -> "))
+	}
+	for _, node := range graph.nodes {
+		_, _ = fmt.Fprintf(w, "  %s[\"%s\"]\n", ids[node], mermaidEscape(graph.label(node)))
+	}
+
+	var highlighted []string
+	edge := 0
+	for _, from := range graph.nodes {
+		for _, to := range graph.edges[from] {
+			_, _ = fmt.Fprintf(w, "  %s --> %s\n", ids[from], ids[to])
+			if inCycle[[2]string{from, to}] {
+				highlighted = append(highlighted, fmt.Sprint(edge))
+			}
+			edge++
+		}
+	}
+
+	if graph.root != "" {
+		_, _ = fmt.Fprintf(w, "  style %s stroke-width:3px\n", ids[graph.root])
+	}
+	if len(highlighted) > 0 {
+		_, _ = fmt.Fprintf(w, "  linkStyle %s stroke:red\n", strings.Join(highlighted, ","))
+	}
+}
+
+func mermaidEscape(s string) string {
+	s = strings.ReplaceAll(s, `"`, "#quot;")
+	return strings.ReplaceAll(s, "\n", "<br/>")
+}
@@ -2,7 +2,10 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
@@ -19,8 +22,35 @@ const (
 
 type beansCommandOperations struct {
 	baseOperations
-	filter string
-	output string
+	filter         string
+	typeFilter     string
+	scopeFilter    string
+	resourceFilter string
+	contextFilter  string
+	beanFilter     beanFilter
+	output         string
+	graph          string
+	root           string
+	depth          int
+	dependents     bool
+	compare        bool
+}
+
+type beansDescribeCommandOperations struct {
+	baseOperations
+	beanName string
+}
+
+// beanFilter selects beans by name, type, scope, resource and application context
+type beanFilter struct {
+	name string
+	// typeFilter and resourceFilter hold the patterns as given by the user, the compiled patterns are used for matching
+	typeFilter      string
+	typePattern     *regexp.Regexp
+	scope           string
+	resourceFilter  string
+	resourcePattern *regexp.Regexp
+	context         string
 }
 
 func NewBeansCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
@@ -37,7 +67,27 @@ func NewBeansCommand(configFlags *genericclioptions.ConfigFlags, podResolver Pod
 		Long: `Get Spring application beans from Spring Boot Actuator.
 
 Displays information about all Spring beans in the application context,
-including their scope, type, and dependencies.`,
+including their scope, type, and dependencies.
+
+The --type filter is a glob pattern matched against the fully qualified type,
+e.g. 'com.example.*' or '*Controller'. Enclose it in slashes to use a regular
+expression instead, e.g. '/(Repository|Dao)$/'. Spring only reports the
+implementation type of a bean, so interfaces are best matched by the naming
+convention of their implementations. The --resource filter is a glob pattern
+matched against the resource the bean was defined in.
+
+With --compare, the beans of all selected pods are compared instead, showing
+the beans that are missing on some pods or differ in context, type or scope.
+This reveals replicas that registered different beans, e.g. because of
+profiles or feature flags during a partial rollout.
+
+Use 'beans describe' to show the dependencies and dependents of a single bean.
+
+With --graph, the dependency graph is written in DOT or Mermaid format instead.
+Use --root to limit it to the dependencies of a single bean, or together with
+--dependents to the beans that inject it. Dependency cycles are reported on
+stderr and highlighted in the graph. A bean name that is defined in several
+application contexts is shown as name@context, which can also be used with --root.`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := operations.complete(cmd); err != nil {
@@ -46,12 +96,66 @@ including their scope, type, and dependencies.`,
 			if err := operations.validate(); err != nil {
 				return err
 			}
+			if operations.compare {
+				return operations.runCompare(cmd.Context())
+			}
 			return RunForEachPod(cmd.Context(), operations.pods, "get beans", operations.runForPod)
 		},
 	}
 
 	cmd.Flags().StringVarP(&operations.filter, "filter", "f", "", "Filter beans by name pattern")
+	cmd.Flags().StringVar(&operations.typeFilter, "type", "", "Filter beans by type glob or /regex/ (e.g., '*Controller')")
+	cmd.Flags().StringVar(&operations.scopeFilter, "scope", "", "Filter beans by scope (e.g., singleton, prototype, request)")
+	cmd.Flags().StringVar(&operations.resourceFilter, "resource", "", "Filter beans by resource glob (e.g., '*DataSourceConfiguration*')")
+	cmd.Flags().StringVar(&operations.contextFilter, "context", "", "Filter beans by application context")
 	cmd.Flags().StringVarP(&operations.output, "output", "o", "", "Output format. One of: wide, name")
+	cmd.Flags().StringVar(&operations.graph, "graph", "", "Write the dependency graph instead. One of: dot, mermaid")
+	cmd.Flags().StringVar(&operations.root, "root", "", "Only include the beans reachable from this bean in the graph")
+	cmd.Flags().IntVar(&operations.depth, "depth", 0, "Maximum distance from --root to include in the graph (0 means no limit)")
+	cmd.Flags().BoolVar(&operations.dependents, "dependents", false, "Follow the beans that depend on --root instead of its dependencies")
+	cmd.Flags().BoolVar(&operations.compare, "compare", false, "Show the beans that differ between the selected pods")
+
+	cmd.AddCommand(newBeansDescribeCommand(configFlags, podResolver))
+
+	return cmd
+}
+
+func newBeansDescribeCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &beansDescribeCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "describe <bean-name>",
+		Short: "Show details of a bean",
+		Long: `Show the details of a single bean, including its aliases, all of its
+dependencies, the beans that depend on it and the parent chain of the
+application context it is defined in.
+
+The bean can also be given by one of its aliases.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd, args); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			return RunForEachPod(cmd.Context(), operations.pods, "describe bean", operations.runForPod)
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) != 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			if err := operations.baseOperations.complete(cmd); err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return operations.validArgsBeanName(cmd.Context())
+		},
+	}
 
 	return cmd
 }
@@ -60,6 +164,49 @@ func (o *beansCommandOperations) validate() error {
 	if err := o.validatePods(); err != nil {
 		return err
 	}
+	if o.graph != "" {
+		if o.graph != graphFormatDot && o.graph != graphFormatMermaid {
+			return fmt.Errorf("invalid graph format '%s'. Must be one of: %s", o.graph, strings.Join(validGraphFormats, ", "))
+		}
+		if o.output != "" || o.filter != "" || o.typeFilter != "" || o.scopeFilter != "" || o.resourceFilter != "" || o.contextFilter != "" {
+			return fmt.Errorf("--graph cannot be used with -o, --filter, --type, --scope, --resource or --context")
+		}
+	} else if o.root != "" || o.depth != 0 || o.dependents {
+		return fmt.Errorf("--root, --depth and --dependents require --graph")
+	}
+	if o.depth < 0 {
+		return fmt.Errorf("--depth must not be negative")
+	}
+	if (o.depth != 0 || o.dependents) && o.root == "" {
+		return fmt.Errorf("--depth and --dependents require --root")
+	}
+	if o.compare {
+		if len(o.pods) < 2 {
+			return fmt.Errorf("--compare requires at least two pods")
+		}
+		if o.graph != "" || o.output == OutputFormatName {
+			return fmt.Errorf("--compare cannot be used with --graph or -o name")
+		}
+	}
+
+	o.beanFilter = beanFilter{
+		name:           o.filter,
+		typeFilter:     o.typeFilter,
+		scope:          o.scopeFilter,
+		resourceFilter: o.resourceFilter,
+		context:        o.contextFilter,
+	}
+	if o.typeFilter != "" {
+		pattern, err := compileTypePattern(o.typeFilter)
+		if err != nil {
+			return err
+		}
+		o.beanFilter.typePattern = pattern
+	}
+	if o.resourceFilter != "" {
+		o.beanFilter.resourcePattern = globToRegexp(o.resourceFilter)
+	}
+
 	return validateOutputFormat(o.output, OutputFormatWide, OutputFormatName)
 }
 
@@ -74,21 +221,92 @@ func (o *beansCommandOperations) runForPod(ctx context.Context, podName string)
 		return err
 	}
 
+	if o.graph != "" {
+		graph, err := buildBeanGraph(beansResponse, o.root, o.depth, o.dependents)
+		if err != nil {
+			return err
+		}
+		displayBeanGraph(graph, o.graph)
+		return nil
+	}
+
 	switch o.output {
 	case OutputFormatName:
-		return displayBeansNames(beansResponse, o.filter)
+		return displayBeansNames(beansResponse, o.beanFilter)
 	case OutputFormatWide:
-		return displayBeansWide(beansResponse, o.filter)
+		return displayBeansWide(beansResponse, o.beanFilter)
 	default:
-		return displayBeansTable(beansResponse, o.filter)
+		return displayBeansTable(beansResponse, o.beanFilter)
 	}
 }
 
-func displayBeansNames(beansResponse *actuator.BeansResponse, filter string) error {
+// compileTypePattern compiles a --type filter, which is a regular expression if enclosed in slashes and a
+// glob pattern otherwise
+func compileTypePattern(pattern string) (*regexp.Regexp, error) {
+	if len(pattern) >= 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		compiled, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid --type regular expression: %w", err)
+		}
+		return compiled, nil
+	}
+	return globToRegexp(pattern), nil
+}
+
+func (f beanFilter) active() bool {
+	return f.name != "" || f.typePattern != nil || f.scope != "" || f.resourcePattern != nil || f.context != ""
+}
+
+func (f beanFilter) matches(contextName, beanName string, bean actuator.Bean) bool {
+	if f.name != "" && !strings.Contains(strings.ToLower(beanName), strings.ToLower(f.name)) {
+		return false
+	}
+	if f.typePattern != nil && !f.typePattern.MatchString(bean.Type) {
+		return false
+	}
+	if f.scope != "" && !strings.EqualFold(beanScope(bean), f.scope) {
+		return false
+	}
+	if f.resourcePattern != nil && !f.resourcePattern.MatchString(bean.Resource) {
+		return false
+	}
+	return f.context == "" || f.context == contextName
+}
+
+// String describes the filter for the message shown if no bean matches it
+func (f beanFilter) String() string {
+	var parts []string
+	if f.name != "" {
+		parts = append(parts, f.name)
+	}
+	if f.typeFilter != "" {
+		parts = append(parts, "type="+f.typeFilter)
+	}
+	if f.scope != "" {
+		parts = append(parts, "scope="+f.scope)
+	}
+	if f.resourceFilter != "" {
+		parts = append(parts, "resource="+f.resourceFilter)
+	}
+	if f.context != "" {
+		parts = append(parts, "context="+f.context)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// beanScope returns the scope of the bean, which Spring omits for singletons
+func beanScope(bean actuator.Bean) string {
+	if bean.Scope == "" {
+		return "singleton"
+	}
+	return bean.Scope
+}
+
+func displayBeansNames(beansResponse *actuator.BeansResponse, filter beanFilter) error {
 	var beanNames []string
-	for _, appCtx := range beansResponse.Contexts {
-		for beanName := range appCtx.Beans {
-			if filter == "" || strings.Contains(strings.ToLower(beanName), strings.ToLower(filter)) {
+	for contextName, appCtx := range beansResponse.Contexts {
+		for beanName, bean := range appCtx.Beans {
+			if filter.matches(contextName, beanName, bean) {
 				beanNames = append(beanNames, beanName)
 			}
 		}
@@ -100,19 +318,19 @@ func displayBeansNames(beansResponse *actuator.BeansResponse, filter string) err
 		fmt.Println(beanName)
 	}
 
-	if filter != "" {
+	if filter.active() {
 		fmt.Printf("\nTotal matching beans: %d\n", len(beanNames))
 	}
 
 	return nil
 }
 
-func displayBeansWide(beansResponse *actuator.BeansResponse, filter string) error {
+func displayBeansWide(beansResponse *actuator.BeansResponse, filter beanFilter) error {
 	for contextName, appCtx := range beansResponse.Contexts {
 		matchingBeans := make(map[string]actuator.Bean)
 
 		for beanName, bean := range appCtx.Beans {
-			if filter == "" || strings.Contains(strings.ToLower(beanName), strings.ToLower(filter)) {
+			if filter.matches(contextName, beanName, bean) {
 				matchingBeans[beanName] = bean
 			}
 		}
@@ -163,7 +381,7 @@ func displayBeansWide(beansResponse *actuator.BeansResponse, filter string) erro
 	return nil
 }
 
-func displayBeansTable(beansResponse *actuator.BeansResponse, filter string) error {
+func displayBeansTable(beansResponse *actuator.BeansResponse, filter beanFilter) error {
 	type beanInfo struct {
 		name    string
 		context string
@@ -173,7 +391,7 @@ func displayBeansTable(beansResponse *actuator.BeansResponse, filter string) err
 
 	for contextName, appCtx := range beansResponse.Contexts {
 		for beanName, bean := range appCtx.Beans {
-			if filter == "" || strings.Contains(strings.ToLower(beanName), strings.ToLower(filter)) {
+			if filter.matches(contextName, beanName, bean) {
 				allBeans = append(allBeans, beanInfo{
 					name:    beanName,
 					context: contextName,
@@ -184,7 +402,7 @@ func displayBeansTable(beansResponse *actuator.BeansResponse, filter string) err
 	}
 
 	if len(allBeans) == 0 {
-		if filter != "" {
+		if filter.active() {
 			fmt.Printf("No beans matching filter: %s\n", filter)
 		} else {
 			fmt.Println("No beans found")
@@ -203,10 +421,7 @@ func displayBeansTable(beansResponse *actuator.BeansResponse, filter string) err
 
 	for _, info := range allBeans {
 		bean := info.bean
-		scope := bean.Scope
-		if scope == "" {
-			scope = "singleton"
-		}
+		scope := beanScope(bean)
 
 		typeName := shortenType(bean.Type, maxBeanTypeLength)
 		beanName := smartTruncate(info.name, maxBeanNameLength)
@@ -217,6 +432,210 @@ func displayBeansTable(beansResponse *actuator.BeansResponse, filter string) err
 	return nil
 }
 
+func (o *beansDescribeCommandOperations) complete(cmd *cobra.Command, args []string) error {
+	if err := o.baseOperations.complete(cmd); err != nil {
+		return err
+	}
+
+	if len(args) >= 1 {
+		o.beanName = args[0]
+	}
+
+	return nil
+}
+
+func (o *beansDescribeCommandOperations) validate() error {
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+	if o.beanName == "" {
+		return errors.New("bean name must not be empty")
+	}
+	return nil
+}
+
+func (o *beansDescribeCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	beansResponse, err := client.GetBeans()
+	if err != nil {
+		return err
+	}
+
+	return describeBean(beansResponse, o.beanName)
+}
+
+func (o *beansDescribeCommandOperations) validArgsBeanName(ctx context.Context) ([]string, cobra.ShellCompDirective) {
+	if len(o.pods) == 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	client, err := o.actuatorClientFactory.NewClient(ctx, o.pods[0])
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	beansResponse, err := client.GetBeans()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	seen := make(map[string]bool)
+	var beanNames []string
+	for _, appCtx := range beansResponse.Contexts {
+		for beanName := range appCtx.Beans {
+			if !seen[beanName] {
+				seen[beanName] = true
+				beanNames = append(beanNames, beanName)
+			}
+		}
+	}
+	sort.Strings(beanNames)
+
+	return beanNames, cobra.ShellCompDirectiveNoFileComp
+}
+
+// describeBean shows every definition of the bean with the given name or alias. A bean name is usually
+// unique, but a child context may define a bean with the same name as its parent.
+func describeBean(beansResponse *actuator.BeansResponse, name string) error {
+	contextNames := make([]string, 0, len(beansResponse.Contexts))
+	for contextName := range beansResponse.Contexts {
+		contextNames = append(contextNames, contextName)
+	}
+	sort.Strings(contextNames)
+
+	found := false
+	for _, contextName := range contextNames {
+		for beanName, bean := range beansResponse.Contexts[contextName].Beans {
+			if beanName != name && !slices.Contains(bean.Aliases, name) {
+				continue
+			}
+			if found {
+				fmt.Println()
+			}
+			found = true
+			displayBeanDetails(beansResponse, contextName, beanName, bean)
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("bean '%s' not found", name)
+	}
+	return nil
+}
+
+func displayBeanDetails(beansResponse *actuator.BeansResponse, contextName, beanName string, bean actuator.Bean) {
+	contextChain := contextHierarchy(beansResponse, contextName)
+
+	w := newTableWriter()
+	_, _ = fmt.Fprintf(w, "NAME\t%s\n", beanName)
+	_, _ = fmt.Fprintf(w, "ALIASES\t%s\n", valueOrDash(strings.Join(bean.Aliases, ", ")))
+	_, _ = fmt.Fprintf(w, "TYPE\t%s\n", bean.Type)
+	_, _ = fmt.Fprintf(w, "SCOPE\t%s\n", beanScope(bean))
+	_, _ = fmt.Fprintf(w, "RESOURCE\t%s\n", valueOrDash(bean.Resource))
+	_, _ = fmt.Fprintf(w, "CONTEXT\t%s\n", strings.Join(contextChain, " -> "))
+	_ = w.Flush()
+
+	fmt.Println()
+	fmt.Printf("DEPENDENCIES (%d)\n", len(bean.Dependencies))
+	if len(bean.Dependencies) > 0 {
+		w = newTableWriter()
+		_, _ = fmt.Fprintln(w, "NAME\tTYPE")
+		for _, dependency := range bean.Dependencies {
+			_, _ = fmt.Fprintf(w, "%s\t%s\n", dependency, resolveBeanType(beansResponse, contextChain, dependency))
+		}
+		_ = w.Flush()
+	}
+
+	dependents := findDependents(beansResponse, contextName, beanName, bean.Aliases)
+	fmt.Println()
+	fmt.Printf("DEPENDENTS (%d)\n", len(dependents))
+	if len(dependents) > 0 {
+		w = newTableWriter()
+		_, _ = fmt.Fprintln(w, "NAME\tTYPE\tCONTEXT")
+		for _, dependent := range dependents {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", dependent.name, shortenType(dependent.bean.Type, maxBeanTypeLength), dependent.context)
+		}
+		_ = w.Flush()
+	}
+}
+
+// contextHierarchy returns the given application context followed by its parents
+func contextHierarchy(beansResponse *actuator.BeansResponse, contextName string) []string {
+	var chain []string
+	seen := make(map[string]bool)
+	for name := contextName; name != "" && !seen[name]; name = beansResponse.Contexts[name].Parent {
+		seen[name] = true
+		chain = append(chain, name)
+	}
+	return chain
+}
+
+// resolveBean looks up a bean by name or alias the way Spring does, in the first context of the chain and
+// then in its parents. It returns the context that defines the bean and the name of the bean.
+func resolveBean(beansResponse *actuator.BeansResponse, contextChain []string, name string) (string, string, bool) {
+	for _, contextName := range contextChain {
+		beans := beansResponse.Contexts[contextName].Beans
+		if _, exists := beans[name]; exists {
+			return contextName, name, true
+		}
+		for beanName, bean := range beans {
+			if slices.Contains(bean.Aliases, name) {
+				return contextName, beanName, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// resolveBeanType returns the type of a dependency. Dependencies that are not beans, like the environment,
+// have no type.
+func resolveBeanType(beansResponse *actuator.BeansResponse, contextChain []string, beanName string) string {
+	if contextName, name, found := resolveBean(beansResponse, contextChain, beanName); found {
+		return shortenType(beansResponse.Contexts[contextName].Beans[name].Type, maxBeanTypeLength)
+	}
+	return "-"
+}
+
+type beanRef struct {
+	name    string
+	context string
+	bean    actuator.Bean
+}
+
+// findDependents returns the beans that depend on the bean defined in the given context. A dependency only
+// counts if it resolves to that context from the context of the dependent, so a bean that is shadowed by a
+// bean with the same name in a child context has no dependents in the child context.
+func findDependents(beansResponse *actuator.BeansResponse, contextName, beanName string, aliases []string) []beanRef {
+	var dependents []beanRef
+	for dependentContext, appCtx := range beansResponse.Contexts {
+		contextChain := contextHierarchy(beansResponse, dependentContext)
+		for name, bean := range appCtx.Beans {
+			for _, dependency := range bean.Dependencies {
+				if dependency != beanName && !slices.Contains(aliases, dependency) {
+					continue
+				}
+				resolvedContext, resolvedName, found := resolveBean(beansResponse, contextChain, dependency)
+				if found && resolvedContext == contextName && resolvedName == beanName {
+					dependents = append(dependents, beanRef{name: name, context: dependentContext, bean: bean})
+					break
+				}
+			}
+		}
+	}
+
+	sort.Slice(dependents, func(i, j int) bool {
+		if dependents[i].name == dependents[j].name {
+			return dependents[i].context < dependents[j].context
+		}
+		return dependents[i].name < dependents[j].name
+	})
+	return dependents
+}
+
 func truncateString(s string, maxLen int) string {
 	if len(s) <= maxLen {
 		return s
@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+// testDriftingBeans returns the beans of two replicas, where the second one enabled a feature flag that
+// replaces the payment client and adds a bean, and runs with a different application context
+func testDriftingBeans() []*actuator.BeansResponse {
+	first := testBeans()
+	first.Contexts["application"].Beans["paymentClient"] = actuator.Bean{Type: "com.example.payment.RestPaymentClient"}
+	first.Contexts["application"].Beans["reportCache"] = actuator.Bean{Type: "com.example.ReportCache"}
+
+	second := testBeans()
+	second.Contexts["application"].Beans["paymentClient"] = actuator.Bean{Type: "com.example.payment.GrpcPaymentClient"}
+	second.Contexts["application"].Beans["reportCache"] = actuator.Bean{Type: "com.example.ReportCache", Scope: "prototype"}
+	second.Contexts["application"].Beans["featureToggles"] = actuator.Bean{Type: "com.example.FeatureToggles"}
+	delete(second.Contexts["bootstrap"].Beans, "lonelyService")
+	second.Contexts["bootstrap"].Beans["clock"] = actuator.Bean{Type: "com.example.Clock"}
+	second.Contexts["bootstrap-2"] = actuator.BeanContext{Beans: map[string]actuator.Bean{
+		"timeZone": {Type: "com.example.TimeZone"},
+	}}
+	delete(second.Contexts["bootstrap"].Beans, "timeZone")
+
+	return []*actuator.BeansResponse{first, second}
+}
+
+func TestCompareBeans(t *testing.T) {
+	beans, drifts := compareBeans(testDriftingBeans(), beanFilter{})
+
+	if beans != 10 {
+		t.Errorf("expected 10 compared beans, got %d", beans)
+	}
+
+	var got []string
+	for _, drift := range drifts {
+		got = append(got, drift.name+":"+drift.difference)
+	}
+	want := "featureToggles:missing,lonelyService:missing,paymentClient:type,reportCache:scope,timeZone:context"
+	if strings.Join(got, ",") != want {
+		t.Errorf("drifts = %s, want %s", strings.Join(got, ","), want)
+	}
+
+	if drifts[0].beans[0] != nil || drifts[0].beans[1].context != "application" {
+		t.Errorf("expected featureToggles only on the second pod, got %+v", drifts[0].beans)
+	}
+}
+
+func TestCompareBeansWithFilter(t *testing.T) {
+	beans, drifts := compareBeans(testDriftingBeans(), beanFilter{name: "payment"})
+
+	if beans != 1 || len(drifts) != 1 || drifts[0].name != "paymentClient" {
+		t.Errorf("unexpected comparison of %d beans: %+v", beans, drifts)
+	}
+}
+
+func TestCompareBeansWithTypeFilter(t *testing.T) {
+	ops := beansCommandOperations{compare: true, typeFilter: "*.RestPaymentClient"}
+	ops.pods = []string{"pod-1", "pod-2"}
+	if err := ops.validate(); err != nil {
+		t.Fatalf("validate() error = %v", err)
+	}
+
+	// The type only matches on the first pod, which is a type difference and not a missing bean
+	beans, drifts := compareBeans(testDriftingBeans(), ops.beanFilter)
+
+	if beans != 1 || len(drifts) != 1 || drifts[0].name != "paymentClient" || drifts[0].difference != beanDriftType {
+		t.Errorf("unexpected comparison of %d beans: %+v", beans, drifts)
+	}
+}
+
+func TestCompareBeansWithContextFilter(t *testing.T) {
+	responses := testDriftingBeans()
+	responses[1].Contexts["bootstrap"].Beans["orderService"] = actuator.Bean{Type: "com.example.service.OrderServiceV2"}
+
+	// orderService is also defined in the application context, which must not hide the bootstrap definition
+	_, drifts := compareBeans(responses, beanFilter{name: "orderservice", context: "bootstrap"})
+
+	if len(drifts) != 1 || drifts[0].difference != beanDriftType || drifts[0].beans[0].context != "bootstrap" {
+		t.Errorf("unexpected drifts: %+v", drifts)
+	}
+}
+
+func TestDisplayBeanDrifts(t *testing.T) {
+	_, drifts := compareBeans(testDriftingBeans(), beanFilter{})
+
+	output := captureOutput(func() {
+		displayBeanDrifts([]string{"pod-1", "pod-2"}, drifts, false)
+	})
+
+	expectedRegex := []string{
+		`BEAN\s+DIFFERENCE\s+pod-1\s+pod-2\n`,
+		`featureToggles\s+missing\s+-\s+c\.e\.FeatureToggles\n`,
+		`lonelyService\s+missing\s+c\.e\.LonelyService\s+-\n`,
+		`paymentClient\s+type\s+c\.e\.p\.RestPaymentClient\s+c\.e\.p\.GrpcPaymentClient\n`,
+		`reportCache\s+scope\s+singleton\s+prototype\n`,
+		`timeZone\s+context\s+bootstrap\s+bootstrap-2\n`,
+	}
+	for _, pattern := range expectedRegex {
+		if !regexp.MustCompile(pattern).MatchString(output) {
+			t.Errorf("expected output to match %q, got:\n%s", pattern, output)
+		}
+	}
+
+	wide := captureOutput(func() {
+		displayBeanDrifts([]string{"pod-1", "pod-2"}, drifts, true)
+	})
+	if !strings.Contains(wide, "com.example.payment.RestPaymentClient") {
+		t.Errorf("expected full types in wide mode, got:\n%s", wide)
+	}
+}
+
+func TestDisplayBeanDriftsWithoutDifferences(t *testing.T) {
+	output := captureOutput(func() {
+		displayBeanDrifts([]string{"pod-1", "pod-2"}, nil, false)
+	})
+
+	if output != "No differences found.\n" {
+		t.Errorf("unexpected output:\n%s", output)
+	}
+}
+
+func TestBeansCompareValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		pods        []string
+		ops         beansCommandOperations
+		errContains string
+	}{
+		{name: "compare", pods: []string{"pod-1", "pod-2"}, ops: beansCommandOperations{compare: true}},
+		{name: "compare wide with filter", pods: []string{"pod-1", "pod-2"}, ops: beansCommandOperations{compare: true, output: "wide", typeFilter: "com.example.*"}},
+		{name: "single pod", pods: []string{"pod-1"}, ops: beansCommandOperations{compare: true}, errContains: "--compare requires at least two pods"},
+		{name: "compare with graph", pods: []string{"pod-1", "pod-2"}, ops: beansCommandOperations{compare: true, graph: "dot"}, errContains: "--compare cannot be used with --graph or -o name"},
+		{name: "compare with name output", pods: []string{"pod-1", "pod-2"}, ops: beansCommandOperations{compare: true, output: "name"}, errContains: "--compare cannot be used with --graph or -o name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := tt.ops
+			ops.pods = tt.pods
+
+			err := ops.validate()
+			if tt.errContains == "" {
+				if err != nil {
+					t.Errorf("validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("validate() error = %v, want error containing %q", err, tt.errContains)
+			}
+		})
+	}
+}
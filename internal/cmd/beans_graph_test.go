@@ -0,0 +1,234 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBuildBeanGraph(t *testing.T) {
+	graph, err := buildBeanGraph(testBeans(), "", 0, false)
+	if err != nil {
+		t.Fatalf("buildBeanGraph() error = %v", err)
+	}
+
+	wantNodes := []string{"auditListener", "clock", "environment", "lonelyService", "orderController", "orderRepository",
+		"orderService@application", "orderService@bootstrap", "timeZone"}
+	if !reflect.DeepEqual(graph.nodes, wantNodes) {
+		t.Errorf("nodes = %v, want %v", graph.nodes, wantNodes)
+	}
+	if got := graph.edges["orderService@application"]; !reflect.DeepEqual(got, []string{"environment", "orderRepository"}) {
+		t.Errorf("edges of orderService@application = %v", got)
+	}
+	if got := graph.edges["orderController"]; !reflect.DeepEqual(got, []string{"orderService@application"}) {
+		t.Errorf("expected the child context to shadow the parent, got edges %v", got)
+	}
+	if got := graph.types["orderService@bootstrap"]; got != "com.example.service.LegacyOrderService" {
+		t.Errorf("expected the bean of the parent context to be kept, got type %s", got)
+	}
+	if want := []string{"orderService@application", "orderService@bootstrap"}; !reflect.DeepEqual(graph.qualified, want) {
+		t.Errorf("qualified = %v, want %v", graph.qualified, want)
+	}
+}
+
+func TestBuildBeanGraphFromRoot(t *testing.T) {
+	tests := []struct {
+		name       string
+		root       string
+		depth      int
+		dependents bool
+		wantNodes  []string
+		wantEdges  map[string][]string
+	}{
+		{
+			name:      "dependencies",
+			root:      "orderController",
+			wantNodes: []string{"environment", "orderController", "orderRepository", "orderService@application"},
+			wantEdges: map[string][]string{"orderController": {"orderService@application"}, "orderService@application": {"environment", "orderRepository"}},
+		},
+		{
+			name:      "limited depth",
+			root:      "orderController",
+			depth:     1,
+			wantNodes: []string{"orderController", "orderService@application"},
+			wantEdges: map[string][]string{"orderController": {"orderService@application"}},
+		},
+		{
+			name:      "cycle at the depth limit",
+			root:      "clock",
+			depth:     1,
+			wantNodes: []string{"clock", "timeZone"},
+			wantEdges: map[string][]string{"clock": {"timeZone"}, "timeZone": {"clock"}},
+		},
+		{
+			name:       "dependents in a cycle at the depth limit",
+			root:       "clock",
+			depth:      1,
+			dependents: true,
+			wantNodes:  []string{"clock", "timeZone"},
+			wantEdges:  map[string][]string{"clock": {"timeZone"}, "timeZone": {"clock"}},
+		},
+		{
+			name:       "dependents",
+			root:       "orderRepository",
+			dependents: true,
+			wantNodes:  []string{"auditListener", "orderController", "orderRepository", "orderService@application"},
+			wantEdges: map[string][]string{
+				"auditListener":            {"orderService@application"},
+				"orderController":          {"orderService@application"},
+				"orderService@application": {"orderRepository"},
+			},
+		},
+		{
+			name:       "dependents of a shadowed bean",
+			root:       "orderService@bootstrap",
+			dependents: true,
+			wantNodes:  []string{"orderService@bootstrap"},
+			wantEdges:  map[string][]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph, err := buildBeanGraph(testBeans(), tt.root, tt.depth, tt.dependents)
+			if err != nil {
+				t.Fatalf("buildBeanGraph() error = %v", err)
+			}
+			if !reflect.DeepEqual(graph.nodes, tt.wantNodes) {
+				t.Errorf("nodes = %v, want %v", graph.nodes, tt.wantNodes)
+			}
+			if !reflect.DeepEqual(graph.edges, tt.wantEdges) {
+				t.Errorf("edges = %v, want %v", graph.edges, tt.wantEdges)
+			}
+		})
+	}
+}
+
+func TestBeanGraphCyclesAtDepthLimit(t *testing.T) {
+	graph, err := buildBeanGraph(testBeans(), "clock", 1, false)
+	if err != nil {
+		t.Fatalf("buildBeanGraph() error = %v", err)
+	}
+
+	want := [][]string{{"clock", "timeZone", "clock"}}
+	if got := graph.cycles(); !reflect.DeepEqual(got, want) {
+		t.Errorf("cycles() = %v, want %v", got, want)
+	}
+}
+
+func TestBuildBeanGraphUnknownRoot(t *testing.T) {
+	_, err := buildBeanGraph(testBeans(), "missing", 0, false)
+	if err == nil || err.Error() != "bean 'missing' not found" {
+		t.Errorf("buildBeanGraph() error = %v", err)
+	}
+
+	_, err = buildBeanGraph(testBeans(), "environment", 0, false)
+	if err == nil || err.Error() != "bean 'environment' not found" {
+		t.Errorf("buildBeanGraph() error = %v", err)
+	}
+}
+
+func TestBuildBeanGraphAmbiguousRoot(t *testing.T) {
+	_, err := buildBeanGraph(testBeans(), "orderService", 0, false)
+	want := "bean 'orderService' is defined in several contexts, use one of: orderService@application, orderService@bootstrap"
+	if err == nil || err.Error() != want {
+		t.Errorf("buildBeanGraph() error = %v, want %s", err, want)
+	}
+}
+
+func TestBeanGraphCycles(t *testing.T) {
+	graph := &beanGraph{
+		nodes: []string{"a", "b", "c", "d", "e", "self"},
+		edges: map[string][]string{
+			"a":    {"b"},
+			"b":    {"c", "d"},
+			"c":    {"a"},
+			"d":    {"e"},
+			"self": {"self"},
+		},
+	}
+
+	want := [][]string{{"a", "b", "c", "a"}, {"self", "self"}}
+	if got := graph.cycles(); !reflect.DeepEqual(got, want) {
+		t.Errorf("cycles() = %v, want %v", got, want)
+	}
+}
+
+func TestWriteDotGraph(t *testing.T) {
+	graph, _ := buildBeanGraph(testBeans(), "clock", 0, false)
+
+	var out strings.Builder
+	writeDotGraph(&out, graph, graph.cycles())
+
+	want := `digraph beans {
+  rankdir=LR;
+  node [shape=box];
+  // cycle: clock -> timeZone -> clock
+  "clock" [label="clock\nc.e.Clock", style=bold];
+  "timeZone" [label="timeZone\nc.e.TimeZone"];
+  "clock" -> "timeZone" [color=red];
+  "timeZone" -> "clock" [color=red];
+}
+`
+	if out.String() != want {
+		t.Errorf("unexpected DOT output:\n%s", out.String())
+	}
+}
+
+func TestWriteMermaidGraph(t *testing.T) {
+	graph, _ := buildBeanGraph(testBeans(), "orderController", 0, false)
+	graph.types["orderRepository"] = `com.example.Repository<"orders">`
+
+	var out strings.Builder
+	writeMermaidGraph(&out, graph, graph.cycles())
+
+	want := `graph LR
+  n0["environment"]
+  n1["orderController<br/>c.e.w.OrderController"]
+  n2["orderRepository<br/>c.e.Repository<#quot;orders#quot;>"]
+  n3["orderService@application<br/>c.e.s.OrderService"]
+  n1 --> n3
+  n3 --> n0
+  n3 --> n2
+  style n1 stroke-width:3px
+`
+	if out.String() != want {
+		t.Errorf("unexpected Mermaid output:\n%s", out.String())
+	}
+}
+
+func TestBeansGraphValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		ops         beansCommandOperations
+		errContains string
+	}{
+		{name: "dot", ops: beansCommandOperations{graph: "dot"}},
+		{name: "mermaid from root", ops: beansCommandOperations{graph: "mermaid", root: "orderService", depth: 2, dependents: true}},
+		{name: "unknown format", ops: beansCommandOperations{graph: "svg"}, errContains: "invalid graph format 'svg'. Must be one of: dot, mermaid"},
+		{name: "graph with output", ops: beansCommandOperations{graph: "dot", output: "wide"}, errContains: "--graph cannot be used with -o, --filter, --type, --scope, --resource or --context"},
+		{name: "graph with filter", ops: beansCommandOperations{graph: "dot", filter: "order"}, errContains: "--graph cannot be used with"},
+		{name: "graph with type", ops: beansCommandOperations{graph: "dot", typeFilter: "*Service"}, errContains: "--graph cannot be used with"},
+		{name: "root without graph", ops: beansCommandOperations{root: "orderService"}, errContains: "--root, --depth and --dependents require --graph"},
+		{name: "negative depth", ops: beansCommandOperations{graph: "dot", root: "orderService", depth: -1}, errContains: "--depth must not be negative"},
+		{name: "dependents without root", ops: beansCommandOperations{graph: "dot", dependents: true}, errContains: "--depth and --dependents require --root"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := tt.ops
+			ops.pods = []string{"pod-1"}
+
+			err := ops.validate()
+			if tt.errContains == "" {
+				if err != nil {
+					t.Errorf("validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("validate() error = %v, want error containing %q", err, tt.errContains)
+			}
+		})
+	}
+}
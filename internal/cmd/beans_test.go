@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+// testBeans returns a controller depending on a service and its repository, and two beans that depend on
+// each other in the parent context
+func testBeans() *actuator.BeansResponse {
+	return &actuator.BeansResponse{Contexts: map[string]actuator.BeanContext{
+		"application": {
+			Parent: "bootstrap",
+			Beans: map[string]actuator.Bean{
+				"orderController": {Type: "com.example.web.OrderController", Dependencies: []string{"orderService"}},
+				"orderService":    {Type: "com.example.service.OrderService", Dependencies: []string{"orderRepository", "environment"}},
+				"orderRepository": {Type: "com.example.data.OrderRepository"},
+				"auditListener":   {Type: "com.example.AuditListener", Dependencies: []string{"orderService"}},
+			},
+		},
+		"bootstrap": {
+			Beans: map[string]actuator.Bean{
+				"clock":         {Type: "com.example.Clock", Dependencies: []string{"timeZone"}},
+				"timeZone":      {Type: "com.example.TimeZone", Dependencies: []string{"clock"}},
+				"orderService":  {Type: "com.example.service.LegacyOrderService"},
+				"lonelyService": {Type: "com.example.LonelyService"},
+			},
+		},
+	}}
+}
+
+func TestBeanFilter(t *testing.T) {
+	tests := []struct {
+		name      string
+		ops       beansCommandOperations
+		wantBeans string
+	}{
+		{name: "no filter", ops: beansCommandOperations{}, wantBeans: "auditListener,clock,lonelyService,orderController,orderRepository,orderService,orderService,timeZone"},
+		{name: "name", ops: beansCommandOperations{filter: "ORDER"}, wantBeans: "orderController,orderRepository,orderService,orderService"},
+		{name: "type glob", ops: beansCommandOperations{typeFilter: "com.example.*.Order*"}, wantBeans: "orderController,orderRepository,orderService"},
+		{name: "type glob without package", ops: beansCommandOperations{typeFilter: "OrderService"}, wantBeans: ""},
+		{name: "type regex", ops: beansCommandOperations{typeFilter: "/(Repository|Listener)$/"}, wantBeans: "auditListener,orderRepository"},
+		{name: "scope", ops: beansCommandOperations{scopeFilter: "Prototype"}, wantBeans: "clock"},
+		{name: "default scope", ops: beansCommandOperations{scopeFilter: "singleton", contextFilter: "bootstrap"}, wantBeans: "lonelyService,orderService,timeZone"},
+		{name: "resource", ops: beansCommandOperations{resourceFilter: "*OrderConfiguration*"}, wantBeans: "orderRepository,orderService"},
+		{name: "context", ops: beansCommandOperations{filter: "order", contextFilter: "bootstrap"}, wantBeans: "orderService"},
+	}
+
+	beans := testBeans()
+	clock := beans.Contexts["bootstrap"].Beans["clock"]
+	clock.Scope = "prototype"
+	beans.Contexts["bootstrap"].Beans["clock"] = clock
+	for _, name := range []string{"orderService", "orderRepository"} {
+		bean := beans.Contexts["application"].Beans[name]
+		bean.Resource = "class path resource [com/example/OrderConfiguration.class]"
+		beans.Contexts["application"].Beans[name] = bean
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := tt.ops
+			ops.pods = []string{"pod-1"}
+			if err := ops.validate(); err != nil {
+				t.Fatalf("validate() error = %v", err)
+			}
+
+			output := captureOutput(func() {
+				_ = displayBeansNames(beans, ops.beanFilter)
+			})
+
+			var names []string
+			for _, line := range strings.Split(output, "\n") {
+				if line == "" || strings.HasPrefix(line, "Total matching beans") {
+					continue
+				}
+				names = append(names, line)
+			}
+			if got := strings.Join(names, ","); got != tt.wantBeans {
+				t.Errorf("beans = %s, want %s", got, tt.wantBeans)
+			}
+		})
+	}
+}
+
+func TestBeansValidation(t *testing.T) {
+	ops := beansCommandOperations{typeFilter: "/(Repository/"}
+	ops.pods = []string{"pod-1"}
+
+	err := ops.validate()
+	if err == nil || !strings.Contains(err.Error(), "invalid --type regular expression") {
+		t.Errorf("validate() error = %v", err)
+	}
+}
+
+func TestDisplayBeansTableNoMatch(t *testing.T) {
+	ops := beansCommandOperations{typeFilter: "com.example.*", scopeFilter: "request", resourceFilter: "*Config*", contextFilter: "application"}
+	ops.pods = []string{"pod-1"}
+	if err := ops.validate(); err != nil {
+		t.Fatalf("validate() error = %v", err)
+	}
+
+	output := captureOutput(func() {
+		_ = displayBeansTable(testBeans(), ops.beanFilter)
+	})
+
+	if !strings.Contains(output, "No beans matching filter: type=com.example.*, scope=request, resource=*Config*, context=application") {
+		t.Errorf("unexpected output:\n%s", output)
+	}
+}
+
+func TestDescribeBean(t *testing.T) {
+	beans := testBeans()
+	service := beans.Contexts["application"].Beans["orderService"]
+	service.Aliases = []string{"orders"}
+	service.Resource = "class path resource [com/example/OrderConfiguration.class]"
+	beans.Contexts["application"].Beans["orderService"] = service
+	audit := beans.Contexts["application"].Beans["auditListener"]
+	audit.Dependencies = []string{"orders"}
+	beans.Contexts["application"].Beans["auditListener"] = audit
+
+	var err error
+	output := captureOutput(func() {
+		err = describeBean(beans, "orders")
+	})
+	if err != nil {
+		t.Fatalf("describeBean() error = %v", err)
+	}
+
+	expectedRegex := []string{
+		`NAME\s+orderService\n`,
+		`ALIASES\s+orders\n`,
+		`TYPE\s+com\.example\.service\.OrderService\n`,
+		`SCOPE\s+singleton\n`,
+		`RESOURCE\s+class path resource \[com/example/OrderConfiguration\.class\]\n`,
+		`CONTEXT\s+application -> bootstrap\n`,
+		`(?s)DEPENDENCIES \(2\)\nNAME\s+TYPE\n` +
+			`orderRepository\s+c\.e\.d\.OrderRepository\n` +
+			`environment\s+-\n`,
+		`(?s)DEPENDENTS \(2\)\nNAME\s+TYPE\s+CONTEXT\n` +
+			`auditListener\s+c\.e\.AuditListener\s+application\n` +
+			`orderController\s+c\.e\.w\.OrderController\s+application\n`,
+	}
+	for _, pattern := range expectedRegex {
+		if !regexp.MustCompile(pattern).MatchString(output) {
+			t.Errorf("expected output to match %q, got:\n%s", pattern, output)
+		}
+	}
+	if strings.Contains(output, "LegacyOrderService") {
+		t.Errorf("expected only the bean with the alias, got:\n%s", output)
+	}
+}
+
+func TestDescribeBeanInSeveralContexts(t *testing.T) {
+	output := captureOutput(func() {
+		_ = describeBean(testBeans(), "orderService")
+	})
+
+	if !strings.Contains(output, "com.example.service.OrderService") || !strings.Contains(output, "com.example.service.LegacyOrderService") {
+		t.Errorf("expected both definitions, got:\n%s", output)
+	}
+	if !regexp.MustCompile(`LegacyOrderService\n.*\n.*\nCONTEXT\s+bootstrap\n\nDEPENDENCIES \(0\)\n\nDEPENDENTS \(0\)\n`).MatchString(output) {
+		t.Errorf("unexpected definition in the parent context:\n%s", output)
+	}
+}
+
+func TestDescribeBeanNotFound(t *testing.T) {
+	err := describeBean(&actuator.BeansResponse{}, "missing")
+	if err == nil || err.Error() != "bean 'missing' not found" {
+		t.Errorf("describeBean() error = %v", err)
+	}
+}
@@ -0,0 +1,251 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const maxCacheTargetLength = 80
+
+type cachesCommandOperations struct {
+	baseOperations
+	output string
+}
+
+type cachesEvictCommandOperations struct {
+	baseOperations
+	cacheName    string
+	cacheManager string
+	all          bool
+}
+
+func NewCachesCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &cachesCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "caches",
+		Short: "List and evict application caches",
+		Long: `List and evict application caches via Spring Boot Actuator.
+
+Without a subcommand, lists all cache managers and their caches.
+Use 'caches evict' to clear a single cache or all caches.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			return RunForEachPod(cmd.Context(), operations.pods, "get caches", operations.runForPod)
+		},
+	}
+
+	cmd.Flags().StringVarP(&operations.output, "output", "o", "", "Output format. One of: wide")
+
+	cmd.AddCommand(newCachesEvictCommand(configFlags, podResolver))
+
+	return cmd
+}
+
+func newCachesEvictCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &cachesEvictCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "evict [cache-name]",
+		Short: "Evict entries from a cache",
+		Long: `Evict all entries from a cache on every selected pod.
+
+Use --all to evict every cache of every cache manager.
+If the same cache name is used by more than one cache manager,
+select the cache manager with --cache-manager.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd, args); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			return RunForEachPod(cmd.Context(), operations.pods, "evict cache", operations.runForPod)
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) != 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			if err := operations.complete(cmd, args); err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return operations.validArgsCacheName(cmd.Context())
+		},
+	}
+
+	cmd.Flags().BoolVar(&operations.all, "all", false, "Evict all caches")
+	cmd.Flags().StringVar(&operations.cacheManager, "cache-manager", "", "Name of the cache manager owning the cache")
+
+	return cmd
+}
+
+func (o *cachesCommandOperations) validate() error {
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+	return validateOutputFormat(o.output, OutputFormatWide)
+}
+
+func (o *cachesCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	caches, err := client.GetCaches()
+	if err != nil {
+		return err
+	}
+
+	displayCachesTable(caches, o.output == OutputFormatWide)
+	return nil
+}
+
+type cacheEntry struct {
+	name         string
+	cacheManager string
+	target       string
+}
+
+func collectCaches(caches *actuator.CachesResponse) []cacheEntry {
+	var entries []cacheEntry
+	for managerName, manager := range caches.CacheManagers {
+		for cacheName, cache := range manager.Caches {
+			entries = append(entries, cacheEntry{
+				name:         cacheName,
+				cacheManager: managerName,
+				target:       cache.Target,
+			})
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].name == entries[j].name {
+			return entries[i].cacheManager < entries[j].cacheManager
+		}
+		return entries[i].name < entries[j].name
+	})
+
+	return entries
+}
+
+func displayCachesTable(caches *actuator.CachesResponse, wideMode bool) {
+	entries := collectCaches(caches)
+	if len(entries) == 0 {
+		fmt.Println("No caches found")
+		return
+	}
+
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	_, _ = fmt.Fprintln(w, "NAME\tCACHE MANAGER\tTARGET")
+	for _, entry := range entries {
+		target := entry.target
+		if !wideMode {
+			target = shortenType(target, maxCacheTargetLength)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", entry.name, entry.cacheManager, target)
+	}
+}
+
+func (o *cachesEvictCommandOperations) complete(cmd *cobra.Command, args []string) error {
+	if err := o.baseOperations.complete(cmd); err != nil {
+		return err
+	}
+
+	if len(args) >= 1 {
+		o.cacheName = args[0]
+	}
+
+	return nil
+}
+
+func (o *cachesEvictCommandOperations) validate() error {
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+
+	if o.all && o.cacheName != "" {
+		return errors.New("cannot specify a cache name together with --all")
+	}
+	if !o.all && o.cacheName == "" {
+		return errors.New("specify a cache name or --all")
+	}
+	if o.all && o.cacheManager != "" {
+		return errors.New("--cache-manager cannot be used with --all")
+	}
+
+	return nil
+}
+
+func (o *cachesEvictCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	if o.all {
+		if err := client.EvictAllCaches(); err != nil {
+			return err
+		}
+		fmt.Println("All caches evicted")
+		return nil
+	}
+
+	if err := client.EvictCache(o.cacheName, o.cacheManager); err != nil {
+		return err
+	}
+	fmt.Printf("Cache '%s' evicted\n", o.cacheName)
+	return nil
+}
+
+func (o *cachesEvictCommandOperations) validArgsCacheName(ctx context.Context) ([]string, cobra.ShellCompDirective) {
+	if len(o.pods) == 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	client, err := o.actuatorClientFactory.NewClient(ctx, o.pods[0])
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	caches, err := client.GetCaches()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	seen := make(map[string]bool)
+	var cacheNames []string
+	for _, entry := range collectCaches(caches) {
+		if !seen[entry.name] {
+			seen[entry.name] = true
+			cacheNames = append(cacheNames, entry.name)
+		}
+	}
+
+	return cacheNames, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func TestCachesEvictValidation(t *testing.T) {
+	tests := []struct {
+		name         string
+		pods         []string
+		cacheName    string
+		cacheManager string
+		all          bool
+		wantErr      bool
+		errContains  string
+	}{
+		{
+			name:      "evict single cache",
+			pods:      []string{"pod-1"},
+			cacheName: "countries",
+			wantErr:   false,
+		},
+		{
+			name:         "evict single cache with cache manager",
+			pods:         []string{"pod-1", "pod-2"},
+			cacheName:    "countries",
+			cacheManager: "cacheManager",
+			wantErr:      false,
+		},
+		{
+			name:    "evict all caches",
+			pods:    []string{"pod-1"},
+			all:     true,
+			wantErr: false,
+		},
+		{
+			name:        "neither cache name nor --all",
+			pods:        []string{"pod-1"},
+			wantErr:     true,
+			errContains: "specify a cache name or --all",
+		},
+		{
+			name:        "cache name and --all",
+			pods:        []string{"pod-1"},
+			cacheName:   "countries",
+			all:         true,
+			wantErr:     true,
+			errContains: "cannot specify a cache name together with --all",
+		},
+		{
+			name:         "cache manager with --all",
+			pods:         []string{"pod-1"},
+			cacheManager: "cacheManager",
+			all:          true,
+			wantErr:      true,
+			errContains:  "--cache-manager cannot be used with --all",
+		},
+		{
+			name:        "no pods specified",
+			pods:        []string{},
+			cacheName:   "countries",
+			wantErr:     true,
+			errContains: "no pods selected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &cachesEvictCommandOperations{
+				baseOperations: baseOperations{pods: tt.pods},
+				cacheName:      tt.cacheName,
+				cacheManager:   tt.cacheManager,
+				all:            tt.all,
+			}
+
+			err := ops.validate()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("expected error containing '%s', got '%v'", tt.errContains, err)
+				}
+			}
+		})
+	}
+}
+
+func TestDisplayCachesTable(t *testing.T) {
+	caches := &actuator.CachesResponse{
+		CacheManagers: map[string]actuator.CacheManager{
+			"cacheManager": {
+				Caches: map[string]actuator.Cache{
+					"countries": {Target: "java.util.concurrent.ConcurrentHashMap"},
+					"cities":    {Target: "java.util.concurrent.ConcurrentHashMap"},
+				},
+			},
+			"anotherCacheManager": {
+				Caches: map[string]actuator.Cache{
+					"countries": {Target: "com.github.benmanes.caffeine.cache.BoundedLocalCache"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		caches      *actuator.CachesResponse
+		wideMode    bool
+		expected    []string
+		notExpected []string
+	}{
+		{
+			name:     "table output shortens targets",
+			caches:   caches,
+			wideMode: false,
+			expected: []string{
+				"NAME",
+				"CACHE MANAGER",
+				"TARGET",
+				"countries",
+				"cities",
+				"anotherCacheManager",
+				"j.u.c.ConcurrentHashMap",
+			},
+			notExpected: []string{"java.util.concurrent.ConcurrentHashMap"},
+		},
+		{
+			name:     "wide output shows full targets",
+			caches:   caches,
+			wideMode: true,
+			expected: []string{
+				"java.util.concurrent.ConcurrentHashMap",
+				"com.github.benmanes.caffeine.cache.BoundedLocalCache",
+			},
+		},
+		{
+			name:     "no caches",
+			caches:   &actuator.CachesResponse{},
+			expected: []string{"No caches found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := captureOutput(func() {
+				displayCachesTable(tt.caches, tt.wideMode)
+			})
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("displayCachesTable() output missing expected value:\n  want: %s\n  got:\n%s", expected, output)
+				}
+			}
+			for _, notExpected := range tt.notExpected {
+				if strings.Contains(output, notExpected) {
+					t.Errorf("displayCachesTable() output contains unexpected value:\n  unwanted: %s\n  got:\n%s", notExpected, output)
+				}
+			}
+		})
+	}
+}
+
+func TestCollectCachesSorting(t *testing.T) {
+	caches := &actuator.CachesResponse{
+		CacheManagers: map[string]actuator.CacheManager{
+			"zManager": {Caches: map[string]actuator.Cache{"b": {}, "a": {}}},
+			"aManager": {Caches: map[string]actuator.Cache{"b": {}}},
+		},
+	}
+
+	entries := collectCaches(caches)
+
+	want := []string{"a/zManager", "b/aManager", "b/zManager"}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(entries), len(want))
+	}
+	for i, entry := range entries {
+		if got := entry.name + "/" + entry.cacheManager; got != want[i] {
+			t.Errorf("entry %d = %s, want %s", i, got, want[i])
+		}
+	}
+}
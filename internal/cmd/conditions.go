@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+type conditionsCommandOperations struct {
+	baseOperations
+	matched     bool
+	unmatched   bool
+	classFilter string
+}
+
+func NewConditionsCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &conditionsCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "conditions",
+		Short: "Get auto-configuration conditions report",
+		Long: `Get the auto-configuration conditions report from Spring Boot Actuator.
+
+Shows, for each application context, which configuration classes matched
+their conditions, which did not (and why), which were excluded and which
+are unconditional.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			return RunForEachPod(cmd.Context(), operations.pods, "get conditions", operations.runForPod)
+		},
+	}
+
+	cmd.Flags().BoolVar(&operations.matched, "matched", false, "Show only positive matches")
+	cmd.Flags().BoolVar(&operations.unmatched, "unmatched", false, "Show only negative matches")
+	cmd.Flags().StringVar(&operations.classFilter, "class", "", "Filter by configuration class name pattern")
+
+	return cmd
+}
+
+func (o *conditionsCommandOperations) validate() error {
+	return o.validatePods()
+}
+
+func (o *conditionsCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	conditions, err := client.GetConditions()
+	if err != nil {
+		return err
+	}
+
+	return o.displayConditions(conditions)
+}
+
+func (o *conditionsCommandOperations) matchesClass(className string) bool {
+	return o.classFilter == "" || strings.Contains(strings.ToLower(className), strings.ToLower(o.classFilter))
+}
+
+func (o *conditionsCommandOperations) displayConditions(conditions *actuator.ConditionsResponse) error {
+	// Without --matched or --unmatched, every section is shown
+	showAll := !o.matched && !o.unmatched
+
+	displayed := false
+	for _, contextName := range slices.Sorted(maps.Keys(conditions.Contexts)) {
+		appCtx := conditions.Contexts[contextName]
+
+		positive := o.filterClassNames(slices.Collect(maps.Keys(appCtx.PositiveMatches)))
+		negative := o.filterClassNames(slices.Collect(maps.Keys(appCtx.NegativeMatches)))
+		exclusions := o.filterClassNames(appCtx.Exclusions)
+		unconditional := o.filterClassNames(appCtx.UnconditionalClasses)
+
+		if !showAll {
+			if !o.matched {
+				positive = nil
+			}
+			if !o.unmatched {
+				negative = nil
+			}
+			exclusions = nil
+			unconditional = nil
+		}
+
+		if len(positive) == 0 && len(negative) == 0 && len(exclusions) == 0 && len(unconditional) == 0 {
+			continue
+		}
+
+		if displayed {
+			fmt.Println()
+		}
+		displayed = true
+
+		fmt.Printf("Context: %s\n", contextName)
+		if appCtx.ParentID != "" {
+			fmt.Printf("Parent: %s\n", appCtx.ParentID)
+		}
+		fmt.Println()
+
+		if showAll || o.matched {
+			fmt.Printf("Positive Matches: %d\n\n", len(positive))
+			for _, className := range positive {
+				fmt.Printf("Class: %s\n", className)
+				displayConditionOutcomes(appCtx.PositiveMatches[className], "  ")
+				fmt.Println()
+			}
+		}
+
+		if showAll || o.unmatched {
+			fmt.Printf("Negative Matches: %d\n\n", len(negative))
+			for _, className := range negative {
+				match := appCtx.NegativeMatches[className]
+				fmt.Printf("Class: %s\n", className)
+				if len(match.NotMatched) > 0 {
+					fmt.Println("  Did not match:")
+					displayConditionOutcomes(match.NotMatched, "    ")
+				}
+				if len(match.Matched) > 0 {
+					fmt.Println("  Matched:")
+					displayConditionOutcomes(match.Matched, "    ")
+				}
+				fmt.Println()
+			}
+		}
+
+		if showAll {
+			fmt.Printf("Exclusions: %d\n", len(exclusions))
+			for _, className := range exclusions {
+				fmt.Printf("  - %s\n", className)
+			}
+			fmt.Println()
+
+			fmt.Printf("Unconditional Classes: %d\n", len(unconditional))
+			for _, className := range unconditional {
+				fmt.Printf("  - %s\n", className)
+			}
+		}
+	}
+
+	if !displayed {
+		if o.classFilter != "" {
+			fmt.Printf("No conditions matching class: %s\n", o.classFilter)
+		} else {
+			fmt.Println("No conditions found")
+		}
+	}
+
+	return nil
+}
+
+func (o *conditionsCommandOperations) filterClassNames(classNames []string) []string {
+	var filtered []string
+	for _, className := range classNames {
+		if o.matchesClass(className) {
+			filtered = append(filtered, className)
+		}
+	}
+	sort.Strings(filtered)
+	return filtered
+}
+
+func displayConditionOutcomes(outcomes []actuator.ConditionOutcome, indent string) {
+	for _, outcome := range outcomes {
+		fmt.Printf("%s- %s: %s\n", indent, outcome.Condition, outcome.Message)
+	}
+}
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func testConditionsResponse() *actuator.ConditionsResponse {
+	return &actuator.ConditionsResponse{
+		Contexts: map[string]actuator.ConditionsContext{
+			"application": {
+				PositiveMatches: map[string][]actuator.ConditionOutcome{
+					"JacksonAutoConfiguration": {
+						{Condition: "OnClassCondition", Message: "@ConditionalOnClass found required class 'com.fasterxml.jackson.databind.ObjectMapper'"},
+					},
+				},
+				NegativeMatches: map[string]actuator.NegativeMatch{
+					"DataSourceAutoConfiguration": {
+						NotMatched: []actuator.ConditionOutcome{
+							{Condition: "OnClassCondition", Message: "@ConditionalOnClass did not find required class 'javax.sql.DataSource'"},
+						},
+					},
+					"WebFluxAutoConfiguration": {
+						NotMatched: []actuator.ConditionOutcome{
+							{Condition: "OnWebApplicationCondition", Message: "not a reactive web application"},
+						},
+						Matched: []actuator.ConditionOutcome{
+							{Condition: "OnClassCondition", Message: "@ConditionalOnClass found required class"},
+						},
+					},
+				},
+				Exclusions:           []string{"org.example.ExcludedAutoConfiguration"},
+				UnconditionalClasses: []string{"org.springframework.boot.autoconfigure.context.ConfigurationPropertiesAutoConfiguration"},
+			},
+		},
+	}
+}
+
+func TestDisplayConditions(t *testing.T) {
+	tests := []struct {
+		name        string
+		matched     bool
+		unmatched   bool
+		classFilter string
+		expected    []string
+		notExpected []string
+	}{
+		{
+			name: "all sections",
+			expected: []string{
+				"Context: application",
+				"Positive Matches: 1",
+				"Class: JacksonAutoConfiguration",
+				"- OnClassCondition: @ConditionalOnClass found required class 'com.fasterxml.jackson.databind.ObjectMapper'",
+				"Negative Matches: 2",
+				"Class: DataSourceAutoConfiguration",
+				"Did not match:",
+				"- OnWebApplicationCondition: not a reactive web application",
+				"Matched:",
+				"Exclusions: 1",
+				"- org.example.ExcludedAutoConfiguration",
+				"Unconditional Classes: 1",
+			},
+		},
+		{
+			name:        "matched only",
+			matched:     true,
+			expected:    []string{"Positive Matches: 1", "JacksonAutoConfiguration"},
+			notExpected: []string{"Negative Matches", "DataSourceAutoConfiguration", "Exclusions", "Unconditional Classes"},
+		},
+		{
+			name:        "unmatched only",
+			unmatched:   true,
+			expected:    []string{"Negative Matches: 2", "DataSourceAutoConfiguration", "WebFluxAutoConfiguration"},
+			notExpected: []string{"Positive Matches", "JacksonAutoConfiguration", "Exclusions"},
+		},
+		{
+			name:        "class filter is case insensitive",
+			classFilter: "datasource",
+			expected:    []string{"Positive Matches: 0", "Negative Matches: 1", "DataSourceAutoConfiguration", "Exclusions: 0"},
+			notExpected: []string{"WebFluxAutoConfiguration", "JacksonAutoConfiguration"},
+		},
+		{
+			name:        "class filter without matches",
+			classFilter: "nonexistent12345",
+			expected:    []string{"No conditions matching class: nonexistent12345"},
+			notExpected: []string{"Context:"},
+		},
+		{
+			name:        "filter hides context with no matching section",
+			matched:     true,
+			classFilter: "DataSource",
+			expected:    []string{"No conditions matching class: DataSource"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &conditionsCommandOperations{
+				matched:     tt.matched,
+				unmatched:   tt.unmatched,
+				classFilter: tt.classFilter,
+			}
+
+			output := captureOutput(func() {
+				if err := ops.displayConditions(testConditionsResponse()); err != nil {
+					t.Errorf("displayConditions() error = %v", err)
+				}
+			})
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("displayConditions() output missing expected value:\n  want: %s\n  got:\n%s", expected, output)
+				}
+			}
+			for _, notExpected := range tt.notExpected {
+				if strings.Contains(output, notExpected) {
+					t.Errorf("displayConditions() output contains unexpected value:\n  unwanted: %s\n  got:\n%s", notExpected, output)
+				}
+			}
+		})
+	}
+}
+
+func TestDisplayConditionsSortsClasses(t *testing.T) {
+	ops := &conditionsCommandOperations{unmatched: true}
+
+	output := captureOutput(func() {
+		_ = ops.displayConditions(testConditionsResponse())
+	})
+
+	dataSource := strings.Index(output, "DataSourceAutoConfiguration")
+	webFlux := strings.Index(output, "WebFluxAutoConfiguration")
+	if dataSource == -1 || webFlux == -1 || dataSource > webFlux {
+		t.Errorf("expected classes sorted alphabetically, got:\n%s", output)
+	}
+}
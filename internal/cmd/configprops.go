@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const (
+	sanitizedValue    = "******"
+	configPropsIndent = "  "
+)
+
+type configPropsCommandOperations struct {
+	baseOperations
+	prefix string
+}
+
+func NewConfigPropsCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &configPropsCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "configprops [prefix]",
+		Short: "Get configuration properties",
+		Long: `Get @ConfigurationProperties beans from Spring Boot Actuator.
+
+Shows the bound configuration of each bean as a tree below its prefix.
+Where available, the origin of a value (and its raw input, if it differs
+from the bound value) is shown next to it. Beans whose prefix continues the
+prefix of another bean are nested below it, e.g. spring.datasource.hikari is
+shown as hikari below spring.datasource.
+
+With a prefix argument, only beans whose prefix starts with it are shown.
+
+Values hidden by the application are shown as '****** (sanitized)'. Set
+management.endpoint.configprops.show-values to reveal them.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd, args); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			return RunForEachPod(cmd.Context(), operations.pods, "get configprops", operations.runForPod)
+		},
+	}
+
+	return cmd
+}
+
+func (o *configPropsCommandOperations) complete(cmd *cobra.Command, args []string) error {
+	if err := o.baseOperations.complete(cmd); err != nil {
+		return err
+	}
+
+	if len(args) >= 1 {
+		o.prefix = args[0]
+	}
+
+	return nil
+}
+
+func (o *configPropsCommandOperations) validate() error {
+	return o.validatePods()
+}
+
+func (o *configPropsCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	configProps, err := client.GetConfigProps()
+	if err != nil {
+		return err
+	}
+
+	o.displayConfigProps(configProps)
+	return nil
+}
+
+type configPropsBean struct {
+	name string
+	actuator.ConfigPropsBean
+}
+
+func (o *configPropsCommandOperations) filterBeans(beans map[string]actuator.ConfigPropsBean) []configPropsBean {
+	var filtered []configPropsBean
+	for name, bean := range beans {
+		if strings.HasPrefix(bean.Prefix, o.prefix) {
+			filtered = append(filtered, configPropsBean{name: name, ConfigPropsBean: bean})
+		}
+	}
+
+	sort.Slice(filtered, func(i, j int) bool {
+		if filtered[i].Prefix == filtered[j].Prefix {
+			return filtered[i].name < filtered[j].name
+		}
+		return filtered[i].Prefix < filtered[j].Prefix
+	})
+
+	return filtered
+}
+
+func (o *configPropsCommandOperations) displayConfigProps(configProps *actuator.ConfigPropsResponse) {
+	displayed := false
+	for _, contextName := range slices.Sorted(maps.Keys(configProps.Contexts)) {
+		appCtx := configProps.Contexts[contextName]
+
+		beans := o.filterBeans(appCtx.Beans)
+		if len(beans) == 0 {
+			continue
+		}
+
+		if displayed {
+			fmt.Println()
+		}
+		displayed = true
+
+		fmt.Printf("Context: %s\n", contextName)
+		if appCtx.ParentID != "" {
+			fmt.Printf("Parent: %s\n", appCtx.ParentID)
+		}
+
+		children := nestBeans(beans)
+		for _, bean := range children[nil] {
+			fmt.Println()
+			displayConfigPropsBean(bean, bean.Prefix, "", children)
+		}
+	}
+
+	if !displayed {
+		if o.prefix != "" {
+			fmt.Printf("No configuration properties matching prefix: %s\n", o.prefix)
+		} else {
+			fmt.Println("No configuration properties found")
+		}
+	}
+}
+
+// nestBeans builds the tree of the beans from the segments of their prefixes. A bean is placed below the
+// bean with the longest prefix that its own prefix continues, e.g. spring.datasource.hikari below
+// spring.datasource. Beans without such a parent are listed under nil. The order of beans is kept.
+func nestBeans(beans []configPropsBean) map[*configPropsBean][]*configPropsBean {
+	children := make(map[*configPropsBean][]*configPropsBean)
+	for i := range beans {
+		var parent *configPropsBean
+		for j := range beans {
+			candidate := &beans[j]
+			if candidate.Prefix == "" || !strings.HasPrefix(beans[i].Prefix, candidate.Prefix+".") {
+				continue
+			}
+			if parent == nil || len(candidate.Prefix) > len(parent.Prefix) {
+				parent = candidate
+			}
+		}
+		children[parent] = append(children[parent], &beans[i])
+	}
+	return children
+}
+
+// displayConfigPropsBean prints a bean with its properties, followed by the beans nested below it, which are
+// labeled with the remaining segments of their prefix
+func displayConfigPropsBean(bean *configPropsBean, label string, indent string, children map[*configPropsBean][]*configPropsBean) {
+	fmt.Printf("%s%s (%s)\n", indent, label, beanType(bean.name, bean.Prefix))
+	displayConfigPropsMap(bean.Properties, bean.Inputs, indent+configPropsIndent)
+	for _, child := range children[bean] {
+		displayConfigPropsBean(child, strings.TrimPrefix(child.Prefix, bean.Prefix+"."), indent+configPropsIndent, children)
+	}
+}
+
+// beanType derives the type of a configuration properties bean from its name. Beans registered
+// through @EnableConfigurationProperties are named "<prefix>-<fully qualified class name>".
+func beanType(beanName string, prefix string) string {
+	if prefix != "" {
+		if typeName, found := strings.CutPrefix(beanName, prefix+"-"); found {
+			return shortenType(typeName, maxBeanTypeLength)
+		}
+	}
+	return beanName
+}
+
+func displayConfigPropsMap(properties map[string]interface{}, inputs map[string]interface{}, indent string) {
+	for _, key := range slices.Sorted(maps.Keys(properties)) {
+		displayConfigPropsNode(indent+key+":", properties[key], inputs[key], indent)
+	}
+}
+
+// displayConfigPropsNode prints a property below label. Inputs mirror the structure of the
+// properties, so the input of a nested property is found by walking both trees in parallel.
+func displayConfigPropsNode(label string, value interface{}, input interface{}, indent string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			fmt.Printf("%s {}\n", label)
+			return
+		}
+		inputs, _ := input.(map[string]interface{})
+		fmt.Println(label)
+		displayConfigPropsMap(v, inputs, indent+configPropsIndent)
+	case []interface{}:
+		if len(v) == 0 {
+			fmt.Printf("%s []\n", label)
+			return
+		}
+		inputs, _ := input.([]interface{})
+		fmt.Println(label)
+		for i, item := range v {
+			var itemInput interface{}
+			if i < len(inputs) {
+				itemInput = inputs[i]
+			}
+			displayConfigPropsNode(indent+configPropsIndent+"-", item, itemInput, indent+configPropsIndent)
+		}
+	default:
+		fmt.Printf("%s %s%s\n", label, formatConfigPropsValue(v), formatConfigPropsInput(v, input))
+	}
+}
+
+func formatConfigPropsValue(value interface{}) string {
+	s := escapeValue(formatJSONValue(value))
+	if s == sanitizedValue {
+		return s + " (sanitized)"
+	}
+	return s
+}
+
+// formatConfigPropsInput describes the origin of a leaf property, including its raw input value
+// when that differs from the bound value (e.g. "30s" bound to a Duration).
+func formatConfigPropsInput(value interface{}, input interface{}) string {
+	details, ok := input.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	var parts []string
+	if inputValue, exists := details["value"]; exists && inputValue != nil {
+		if formatJSONValue(inputValue) != formatJSONValue(value) {
+			parts = append(parts, "input: "+formatConfigPropsValue(inputValue))
+		}
+	}
+	if origin, ok := details["origin"].(string); ok && origin != "" {
+		parts = append(parts, "origin: "+origin)
+	}
+
+	if len(parts) == 0 {
+		return ""
+	}
+	return "  [" + strings.Join(parts, ", ") + "]"
+}
@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func testConfigPropsResponse() *actuator.ConfigPropsResponse {
+	return &actuator.ConfigPropsResponse{
+		Contexts: map[string]actuator.ConfigPropsContext{
+			"application": {
+				Beans: map[string]actuator.ConfigPropsBean{
+					"spring.cache-org.springframework.boot.autoconfigure.cache.CacheProperties": {
+						Prefix: "spring.cache",
+						Properties: map[string]interface{}{
+							"cacheNames": []interface{}{"testCache"},
+							"redis": map[string]interface{}{
+								"timeToLive":      "PT30S",
+								"cacheNullValues": true,
+							},
+							"caffeine": map[string]interface{}{},
+						},
+						Inputs: map[string]interface{}{
+							"cacheNames": []interface{}{
+								map[string]interface{}{"value": "testCache", "origin": "class path resource [application.yml] - 5:18"},
+							},
+							"redis": map[string]interface{}{
+								"timeToLive":      map[string]interface{}{"value": "30s", "origin": "System Environment Property \"SPRING_CACHE_REDIS_TIME_TO_LIVE\""},
+								"cacheNullValues": map[string]interface{}{},
+							},
+						},
+					},
+					"spring.datasource-org.springframework.boot.autoconfigure.jdbc.DataSourceProperties": {
+						Prefix: "spring.datasource",
+						Properties: map[string]interface{}{
+							"password": "******",
+							"url":      "jdbc:postgresql://db/app",
+						},
+						Inputs: map[string]interface{}{
+							"password": map[string]interface{}{"value": "******", "origin": "class path resource [application.yml] - 9:15"},
+							"url":      map[string]interface{}{"value": "jdbc:postgresql://db/app", "origin": "class path resource [application.yml] - 10:10"},
+						},
+					},
+					"dataSource": {
+						Prefix:     "spring.datasource.hikari",
+						Properties: map[string]interface{}{"maximumPoolSize": float64(10), "maxLifetime": float64(1800000)},
+						Inputs: map[string]interface{}{
+							"maxLifetime": map[string]interface{}{"value": "1800000", "origin": "class path resource [application.yml] - 12:23"},
+						},
+					},
+					"customProperties": {
+						Prefix:     "app",
+						Properties: map[string]interface{}{"name": nil},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDisplayConfigProps(t *testing.T) {
+	tests := []struct {
+		name        string
+		prefix      string
+		expected    []string
+		notExpected []string
+	}{
+		{
+			name: "all beans",
+			expected: []string{
+				"Context: application",
+				"app (customProperties)",
+				"  name: null",
+				"spring.cache (o.s.b.a.c.CacheProperties)",
+				"  cacheNames:\n    - testCache  [origin: class path resource [application.yml] - 5:18]",
+				"  caffeine: {}",
+				"  redis:\n    cacheNullValues: true\n    timeToLive: PT30S  [input: 30s, origin: System Environment Property \"SPRING_CACHE_REDIS_TIME_TO_LIVE\"]",
+				"spring.datasource (o.s.b.a.j.DataSourceProperties)",
+				"  password: ****** (sanitized)  [origin: class path resource [application.yml] - 9:15]",
+				"  url: jdbc:postgresql://db/app  [origin: class path resource [application.yml] - 10:10]\n" +
+					"  hikari (dataSource)\n" +
+					"    maxLifetime: 1800000  [origin: class path resource [application.yml] - 12:23]\n" +
+					"    maximumPoolSize: 10\n",
+			},
+			notExpected: []string{"spring.datasource.hikari", "1.8e+06", "input: 1800000"},
+		},
+		{
+			name:        "filter by prefix",
+			prefix:      "spring.data",
+			expected:    []string{"spring.datasource (o.s.b.a.j.DataSourceProperties)", "  hikari (dataSource)"},
+			notExpected: []string{"spring.cache", "app (customProperties)"},
+		},
+		{
+			name:        "filter by prefix of a nested bean",
+			prefix:      "spring.datasource.hikari",
+			expected:    []string{"spring.datasource.hikari (dataSource)\n  maxLifetime: 1800000"},
+			notExpected: []string{"DataSourceProperties"},
+		},
+		{
+			name:        "prefix without matches",
+			prefix:      "nonexistent",
+			expected:    []string{"No configuration properties matching prefix: nonexistent"},
+			notExpected: []string{"Context:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &configPropsCommandOperations{prefix: tt.prefix}
+
+			output := captureOutput(func() {
+				ops.displayConfigProps(testConfigPropsResponse())
+			})
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("displayConfigProps() output missing expected value:\n  want: %s\n  got:\n%s", expected, output)
+				}
+			}
+			for _, notExpected := range tt.notExpected {
+				if strings.Contains(output, notExpected) {
+					t.Errorf("displayConfigProps() output contains unexpected value:\n  unwanted: %s\n  got:\n%s", notExpected, output)
+				}
+			}
+		})
+	}
+}
+
+func TestDisplayConfigPropsSortsBeansByPrefix(t *testing.T) {
+	ops := &configPropsCommandOperations{}
+
+	output := captureOutput(func() {
+		ops.displayConfigProps(testConfigPropsResponse())
+	})
+
+	app := strings.Index(output, "app (")
+	cache := strings.Index(output, "spring.cache (")
+	dataSource := strings.Index(output, "spring.datasource (")
+	if app == -1 || cache == -1 || dataSource == -1 || app > cache || cache > dataSource {
+		t.Errorf("expected beans sorted by prefix, got:\n%s", output)
+	}
+}
+
+func TestFormatConfigPropsValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{name: "string", value: "hello", want: "hello"},
+		{name: "number", value: float64(8080), want: "8080"},
+		{name: "large number", value: float64(1800000), want: "1800000"},
+		{name: "fraction", value: 0.75, want: "0.75"},
+		{name: "boolean", value: true, want: "true"},
+		{name: "null", value: nil, want: "null"},
+		{name: "sanitized", value: "******", want: "****** (sanitized)"},
+		{name: "escaped newline", value: "a\nb", want: "a\\nb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatConfigPropsValue(tt.value); got != tt.want {
+				t.Errorf("formatConfigPropsValue(%v) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBeanType(t *testing.T) {
+	tests := []struct {
+		beanName string
+		prefix   string
+		want     string
+	}{
+		{"server-org.springframework.boot.autoconfigure.web.ServerProperties", "server", "o.s.b.a.w.ServerProperties"},
+		{"myProperties", "app", "myProperties"},
+		{"myProperties", "", "myProperties"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.beanName, func(t *testing.T) {
+			if got := beanType(tt.beanName, tt.prefix); got != tt.want {
+				t.Errorf("beanType(%q, %q) = %q, want %q", tt.beanName, tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNestBeans(t *testing.T) {
+	beans := []configPropsBean{
+		{name: "root", ConfigPropsBean: actuator.ConfigPropsBean{Prefix: ""}},
+		{name: "server", ConfigPropsBean: actuator.ConfigPropsBean{Prefix: "server"}},
+		{name: "ssl", ConfigPropsBean: actuator.ConfigPropsBean{Prefix: "server.ssl.bundle"}},
+		{name: "servlet", ConfigPropsBean: actuator.ConfigPropsBean{Prefix: "server.servlet"}},
+		{name: "session", ConfigPropsBean: actuator.ConfigPropsBean{Prefix: "server.servlet.session"}},
+		{name: "serverless", ConfigPropsBean: actuator.ConfigPropsBean{Prefix: "serverless"}},
+	}
+
+	children := nestBeans(beans)
+
+	names := func(beans []*configPropsBean) string {
+		var result []string
+		for _, bean := range beans {
+			result = append(result, bean.name)
+		}
+		return strings.Join(result, ",")
+	}
+	if got := names(children[nil]); got != "root,server,serverless" {
+		t.Errorf("top level beans = %s", got)
+	}
+	if got := names(children[&beans[1]]); got != "ssl,servlet" {
+		t.Errorf("beans below server = %s", got)
+	}
+	if got := names(children[&beans[3]]); got != "session" {
+		t.Errorf("beans below server.servlet = %s", got)
+	}
+}
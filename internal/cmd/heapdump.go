@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const (
+	heapDumpTimestampFormat  = "20060102-150405"
+	partialFileSuffix        = ".part"
+	progressUpdateInterval   = 500 * time.Millisecond
+	defaultHeapDumpOutputDir = "."
+)
+
+type heapdumpCommandOperations struct {
+	baseOperations
+	outputDir string
+}
+
+func NewHeapDumpCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &heapdumpCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "heapdump",
+		Short: "Download a heap dump",
+		Long: `Download a heap dump from Spring Boot Actuator.
+
+Streams the heap dump of each selected pod to <pod>-<timestamp>.hprof
+in the output directory. The application creates a fresh dump for every
+request, so an interrupted download cannot be resumed; the partial file
+is removed instead.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			return RunForEachPod(cmd.Context(), operations.pods, "download heap dump", operations.runForPod)
+		},
+	}
+
+	cmd.Flags().StringVarP(&operations.outputDir, "output-dir", "o", defaultHeapDumpOutputDir, "Directory to write heap dumps to")
+
+	return cmd
+}
+
+func (o *heapdumpCommandOperations) validate() error {
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+
+	if info, err := os.Stat(o.outputDir); err == nil && !info.IsDir() {
+		return fmt.Errorf("output path %q is not a directory", o.outputDir)
+	}
+
+	return nil
+}
+
+func (o *heapdumpCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(o.outputDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	fmt.Println("Requesting heap dump, this may take a while...")
+
+	stream, err := client.GetHeapDump(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = stream.Body.Close() }()
+
+	path := filepath.Join(o.outputDir, heapDumpFileName(podName, time.Now()))
+	progress := newProgressWriter(os.Stderr, filepath.Base(path), stream.ContentLength, isTerminal(os.Stderr))
+
+	written, err := saveStream(stream.Body, path, progress)
+	progress.finish()
+	if err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("download interrupted, partial file removed: %w", ctx.Err())
+		}
+		return err
+	}
+
+	fmt.Printf("Heap dump saved to %s (%s)\n", path, formatBytesHuman(float64(written)))
+	return nil
+}
+
+func heapDumpFileName(podName string, t time.Time) string {
+	return fmt.Sprintf("%s-%s.hprof", podName, t.Format(heapDumpTimestampFormat))
+}
+
+// saveStream copies r into a temporary file next to path and renames it once the copy is complete.
+// The temporary file is removed if the copy fails, e.g. because the download was interrupted.
+func saveStream(r io.Reader, path string, progress io.Writer) (int64, error) {
+	partialPath := path + partialFileSuffix
+
+	file, err := os.Create(partialPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create file: %w", err)
+	}
+
+	written, copyErr := io.Copy(io.MultiWriter(file, progress), r)
+	closeErr := file.Close()
+
+	if copyErr != nil || closeErr != nil {
+		_ = os.Remove(partialPath)
+		if copyErr != nil {
+			return written, fmt.Errorf("failed to download: %w", copyErr)
+		}
+		return written, fmt.Errorf("failed to write file: %w", closeErr)
+	}
+
+	if err := os.Rename(partialPath, path); err != nil {
+		_ = os.Remove(partialPath)
+		return written, fmt.Errorf("failed to rename file: %w", err)
+	}
+
+	return written, nil
+}
+
+// progressWriter counts the bytes written to it and periodically reports the progress on a single line.
+type progressWriter struct {
+	out        io.Writer
+	label      string
+	total      int64
+	written    int64
+	enabled    bool
+	lastUpdate time.Time
+}
+
+func newProgressWriter(out io.Writer, label string, total int64, enabled bool) *progressWriter {
+	return &progressWriter{out: out, label: label, total: total, enabled: enabled}
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	p.written += int64(len(b))
+	if p.enabled && time.Since(p.lastUpdate) >= progressUpdateInterval {
+		p.lastUpdate = time.Now()
+		p.print()
+	}
+	return len(b), nil
+}
+
+func (p *progressWriter) finish() {
+	if !p.enabled {
+		return
+	}
+	p.print()
+	_, _ = fmt.Fprintln(p.out)
+}
+
+func (p *progressWriter) print() {
+	_, _ = fmt.Fprintf(p.out, "\r%s: %s", p.label, p.String())
+}
+
+func (p *progressWriter) String() string {
+	if p.total <= 0 {
+		return formatBytesHuman(float64(p.written))
+	}
+	percent := float64(p.written) / float64(p.total) * 100
+	return fmt.Sprintf("%s / %s (%.0f%%)", formatBytesHuman(float64(p.written)), formatBytesHuman(float64(p.total)), percent)
+}
+
+// isTerminal reports whether f is attached to a terminal rather than a pipe or file.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
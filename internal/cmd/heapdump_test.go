@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type failingReader struct {
+	data []byte
+	err  error
+	read bool
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if !r.read {
+		r.read = true
+		return copy(p, r.data), nil
+	}
+	return 0, r.err
+}
+
+func TestHeapDumpFileName(t *testing.T) {
+	ts := time.Date(2025, 3, 14, 9, 26, 53, 0, time.UTC)
+	got := heapDumpFileName("my-app-5d4c8f9b-xk7pq", ts)
+	want := "my-app-5d4c8f9b-xk7pq-20250314-092653.hprof"
+	if got != want {
+		t.Errorf("heapDumpFileName() = %s, want %s", got, want)
+	}
+}
+
+func TestSaveStream(t *testing.T) {
+	tests := []struct {
+		name        string
+		reader      io.Reader
+		wantErr     bool
+		wantContent string
+	}{
+		{
+			name:        "complete download",
+			reader:      strings.NewReader("JAVA PROFILE 1.0.2"),
+			wantErr:     false,
+			wantContent: "JAVA PROFILE 1.0.2",
+		},
+		{
+			name:    "interrupted download",
+			reader:  &failingReader{data: []byte("JAVA PRO"), err: errors.New("context canceled")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "pod.hprof")
+
+			_, err := saveStream(tt.reader, path, io.Discard)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("saveStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if _, statErr := os.Stat(path + partialFileSuffix); !os.IsNotExist(statErr) {
+				t.Errorf("partial file should not exist after saveStream()")
+			}
+
+			if tt.wantErr {
+				if _, statErr := os.Stat(path); !os.IsNotExist(statErr) {
+					t.Errorf("target file should not exist after failed download")
+				}
+				return
+			}
+
+			content, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read saved file: %v", err)
+			}
+			if string(content) != tt.wantContent {
+				t.Errorf("saved content = %q, want %q", string(content), tt.wantContent)
+			}
+		})
+	}
+}
+
+func TestProgressWriter(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int64
+		written  int
+		expected string
+	}{
+		{"known size", 2048, 1024, "1.0 KB / 2.0 KB (50%)"},
+		{"unknown size", -1, 1536, "1.5 KB"},
+		{"nothing written", 1024, 0, "0 B / 1.0 KB (0%)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newProgressWriter(io.Discard, "pod.hprof", tt.total, false)
+			_, _ = p.Write(make([]byte, tt.written))
+
+			if got := p.String(); got != tt.expected {
+				t.Errorf("progressWriter.String() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestProgressWriterDisabledPrintsNothing(t *testing.T) {
+	var out bytes.Buffer
+	p := newProgressWriter(&out, "pod.hprof", 100, false)
+	_, _ = p.Write(make([]byte, 100))
+	p.finish()
+
+	if out.Len() != 0 {
+		t.Errorf("expected no progress output when disabled, got %q", out.String())
+	}
+}
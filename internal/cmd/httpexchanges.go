@@ -0,0 +1,414 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const (
+	exchangeTimestampFormat      = "2006-01-02 15:04:05.000"
+	defaultExchangesPollInterval = 2 * time.Second
+)
+
+var statusFilterPattern = regexp.MustCompile(`^[1-5]([0-9]{2}|xx)$`)
+
+type exchangesFetcher func(client actuator.Client) (*actuator.HTTPExchangesResponse, error)
+
+type httpExchangesCommandOperations struct {
+	baseOperations
+	endpoint     string
+	fetch        exchangesFetcher
+	output       string
+	statusFilter string
+	pathFilter   string
+	pathPattern  *regexp.Regexp
+	minDuration  time.Duration
+	follow       bool
+	interval     time.Duration
+}
+
+func NewHTTPExchangesCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	return newHTTPExchangesCommand(configFlags, podResolver, "httpexchanges", actuator.Client.GetHTTPExchanges,
+		`List recent HTTP exchanges from Spring Boot Actuator.
+
+Requires an HttpExchangeRepository bean in the application (Spring Boot 3+).
+For Spring Boot 2 applications use the httptrace command.`)
+}
+
+func NewHTTPTraceCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	return newHTTPExchangesCommand(configFlags, podResolver, "httptrace", actuator.Client.GetHTTPTrace,
+		`List recent HTTP exchanges from the httptrace endpoint of Spring Boot 2 applications.
+
+Requires an HttpTraceRepository bean in the application.
+For Spring Boot 3 applications use the httpexchanges command.`)
+}
+
+func newHTTPExchangesCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver, use string, fetch exchangesFetcher, description string) *cobra.Command {
+	operations := &httpExchangesCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+		endpoint: use,
+		fetch:    fetch,
+	}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: "List recent HTTP exchanges",
+		Long: description + `
+
+The --status filter accepts a status class (e.g. 5xx) or an exact status code.
+The --path filter is a glob pattern matched against the request path.
+
+With --follow, the endpoint of every selected pod is polled and only
+exchanges that have not been shown yet are printed. The polling requests
+themselves are not shown.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			if operations.follow {
+				return operations.runFollow(cmd.Context())
+			}
+			return RunForEachPod(cmd.Context(), operations.pods, "get "+use, operations.runForPod)
+		},
+	}
+
+	cmd.Flags().StringVarP(&operations.output, "output", "o", "", "Output format. One of: wide")
+	cmd.Flags().StringVar(&operations.statusFilter, "status", "", "Filter by status class or code (e.g., 5xx, 404)")
+	cmd.Flags().StringVar(&operations.pathFilter, "path", "", "Filter by request path glob (e.g., /api/*)")
+	cmd.Flags().DurationVar(&operations.minDuration, "min-duration", 0, "Only show exchanges that took at least this long (e.g., 500ms)")
+	cmd.Flags().BoolVarP(&operations.follow, "follow", "f", false, "Poll for new exchanges until interrupted")
+	cmd.Flags().DurationVar(&operations.interval, "interval", defaultExchangesPollInterval, "Polling interval for --follow")
+
+	return cmd
+}
+
+func (o *httpExchangesCommandOperations) validate() error {
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+
+	if err := validateOutputFormat(o.output, OutputFormatWide); err != nil {
+		return err
+	}
+
+	if o.statusFilter != "" {
+		o.statusFilter = strings.ToLower(o.statusFilter)
+		if !statusFilterPattern.MatchString(o.statusFilter) {
+			return fmt.Errorf("invalid status filter '%s': expected a status class (e.g., 5xx) or a status code (e.g., 404)", o.statusFilter)
+		}
+	}
+
+	if o.pathFilter != "" {
+		o.pathPattern = globToRegexp(o.pathFilter)
+	}
+
+	if o.minDuration < 0 {
+		return fmt.Errorf("--min-duration must not be negative")
+	}
+
+	if o.interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+
+	return nil
+}
+
+func (o *httpExchangesCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	response, err := o.fetch(client)
+	if err != nil {
+		return err
+	}
+
+	exchanges := o.filterExchanges(response.Exchanges)
+	if len(exchanges) == 0 {
+		fmt.Println("No HTTP exchanges found")
+		return nil
+	}
+
+	displayExchangesTable(exchangeRows("", exchanges), false, o.output == OutputFormatWide)
+	return nil
+}
+
+// runFollow polls the endpoint of every pod until the context is cancelled. The exchange repository
+// only holds the most recent exchanges, so each poll is compared against the previous one.
+func (o *httpExchangesCommandOperations) runFollow(ctx context.Context) error {
+	clients := make(map[string]actuator.Client, len(o.pods))
+	for _, pod := range o.pods {
+		client, err := o.actuatorClientFactory.NewClient(ctx, pod)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %s: %v\n", pod, err)
+			continue
+		}
+		clients[pod] = client
+	}
+	if len(clients) == 0 {
+		return fmt.Errorf("follow failed on %d pod(s)", len(o.pods))
+	}
+
+	showPod := len(o.pods) > 1
+	wideMode := o.output == OutputFormatWide
+	widths := followColumnWidths(o.pods, showPod, wideMode)
+	seen := make(map[string]map[exchangeKey]struct{}, len(clients))
+	printHeader := true
+
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	for {
+		var rows []exchangeRow
+		for _, pod := range o.pods {
+			client, ok := clients[pod]
+			if !ok {
+				continue
+			}
+
+			response, err := o.fetch(client)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				_, _ = fmt.Fprintf(os.Stderr, "Error: %s: %v\n", pod, err)
+				continue
+			}
+
+			var fresh []actuator.HTTPExchange
+			fresh, seen[pod] = newExchanges(response.Exchanges, seen[pod])
+			fresh = o.withoutPollingRequests(fresh)
+			rows = append(rows, exchangeRows(pod, o.filterExchanges(fresh))...)
+		}
+
+		if len(rows) > 0 {
+			sortExchangeRows(rows)
+			if printHeader {
+				printFixedWidthColumns(exchangeHeader(showPod, wideMode), widths)
+				printHeader = false
+			}
+			for _, row := range rows {
+				printFixedWidthColumns(exchangeColumns(row, showPod, wideMode), widths)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+type exchangeKey struct {
+	timestamp string
+	uri       string
+}
+
+// newExchanges returns the exchanges that are not in seen, together with the keys of all given
+// exchanges. Exchanges evicted from the repository never reappear, so their keys can be dropped.
+func newExchanges(exchanges []actuator.HTTPExchange, seen map[exchangeKey]struct{}) ([]actuator.HTTPExchange, map[exchangeKey]struct{}) {
+	current := make(map[exchangeKey]struct{}, len(exchanges))
+	var fresh []actuator.HTTPExchange
+	for _, exchange := range exchanges {
+		key := exchangeKey{timestamp: exchange.Timestamp, uri: exchange.Request.URI}
+		if _, ok := current[key]; ok {
+			continue
+		}
+		current[key] = struct{}{}
+		if _, ok := seen[key]; !ok {
+			fresh = append(fresh, exchange)
+		}
+	}
+	return fresh, current
+}
+
+// withoutPollingRequests drops the requests --follow itself sends, which would otherwise show up on every poll
+func (o *httpExchangesCommandOperations) withoutPollingRequests(exchanges []actuator.HTTPExchange) []actuator.HTTPExchange {
+	var filtered []actuator.HTTPExchange
+	for _, exchange := range exchanges {
+		if !strings.HasSuffix(requestPath(exchange.Request.URI), "/"+o.endpoint) {
+			filtered = append(filtered, exchange)
+		}
+	}
+	return filtered
+}
+
+func (o *httpExchangesCommandOperations) filterExchanges(exchanges []actuator.HTTPExchange) []actuator.HTTPExchange {
+	var filtered []actuator.HTTPExchange
+	for _, exchange := range exchanges {
+		if o.statusFilter != "" && !matchesStatus(exchange.Response.Status, o.statusFilter) {
+			continue
+		}
+		if o.pathPattern != nil && !o.pathPattern.MatchString(requestPath(exchange.Request.URI)) {
+			continue
+		}
+		if exchange.TimeTaken.Duration < o.minDuration {
+			continue
+		}
+		filtered = append(filtered, exchange)
+	}
+	return filtered
+}
+
+// matchesStatus reports whether status matches a filter such as "5xx" or "404".
+func matchesStatus(status int, filter string) bool {
+	if strings.HasSuffix(filter, "xx") {
+		return strconv.Itoa(status/100) == filter[:1]
+	}
+	return strconv.Itoa(status) == filter
+}
+
+func requestPath(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Path == "" {
+		return uri
+	}
+	return parsed.Path
+}
+
+// requestPathAndQuery strips the scheme and host from uri, which are the same for every exchange of a pod.
+func requestPathAndQuery(uri string) string {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Path == "" {
+		return uri
+	}
+	return parsed.RequestURI()
+}
+
+type exchangeRow struct {
+	pod       string
+	timestamp *time.Time
+	exchange  actuator.HTTPExchange
+}
+
+func exchangeRows(pod string, exchanges []actuator.HTTPExchange) []exchangeRow {
+	rows := make([]exchangeRow, 0, len(exchanges))
+	for _, exchange := range exchanges {
+		rows = append(rows, exchangeRow{pod: pod, timestamp: parseTime(exchange.Timestamp), exchange: exchange})
+	}
+	sortExchangeRows(rows)
+	return rows
+}
+
+// sortExchangeRows orders rows from oldest to newest, so the latest exchanges end up at the bottom
+func sortExchangeRows(rows []exchangeRow) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].timestamp == nil || rows[j].timestamp == nil {
+			return rows[i].exchange.Timestamp < rows[j].exchange.Timestamp
+		}
+		return rows[i].timestamp.Before(*rows[j].timestamp)
+	})
+}
+
+func displayExchangesTable(rows []exchangeRow, showPod bool, wideMode bool) {
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	_, _ = fmt.Fprintln(w, strings.Join(exchangeHeader(showPod, wideMode), "\t"))
+	for _, row := range rows {
+		_, _ = fmt.Fprintln(w, strings.Join(exchangeColumns(row, showPod, wideMode), "\t"))
+	}
+}
+
+func exchangeHeader(showPod bool, wideMode bool) []string {
+	var header []string
+	if showPod {
+		header = append(header, "POD")
+	}
+	header = append(header, "TIMESTAMP", "METHOD", "URI", "STATUS", "TIME")
+	if wideMode {
+		header = append(header, "REMOTE ADDRESS", "PRINCIPAL")
+	}
+	return header
+}
+
+func exchangeColumns(row exchangeRow, showPod bool, wideMode bool) []string {
+	var columns []string
+	if showPod {
+		columns = append(columns, row.pod)
+	}
+
+	timestamp := row.exchange.Timestamp
+	if row.timestamp != nil {
+		timestamp = row.timestamp.Local().Format(exchangeTimestampFormat)
+	}
+
+	uri := requestPathAndQuery(row.exchange.Request.URI)
+	if wideMode {
+		uri = row.exchange.Request.URI
+	}
+
+	columns = append(columns,
+		timestamp,
+		row.exchange.Request.Method,
+		uri,
+		strconv.Itoa(row.exchange.Response.Status),
+		formatDurationPrecise(row.exchange.TimeTaken.Duration),
+	)
+
+	if wideMode {
+		principal := ""
+		if row.exchange.Principal != nil {
+			principal = row.exchange.Principal.Name
+		}
+		columns = append(columns, valueOrDash(row.exchange.Request.RemoteAddress), valueOrDash(principal))
+	}
+	return columns
+}
+
+// followColumnWidths returns the column widths used by --follow. Every poll prints its own batch of rows,
+// so the widths cannot depend on the rows and are fixed up front to keep the batches aligned with the
+// header. A longer value only shifts the rest of its own row.
+func followColumnWidths(pods []string, showPod bool, wideMode bool) []int {
+	var widths []int
+	if showPod {
+		podWidth := len("POD")
+		for _, pod := range pods {
+			podWidth = max(podWidth, len(pod))
+		}
+		widths = append(widths, podWidth)
+	}
+	uriWidth := 40
+	if wideMode {
+		uriWidth = 60
+	}
+	widths = append(widths, len(exchangeTimestampFormat), len("OPTIONS"), uriWidth, len("STATUS"), len("999.99s"))
+	if wideMode {
+		widths = append(widths, len("REMOTE ADDRESS"), len("PRINCIPAL"))
+	}
+	return widths
+}
+
+// printFixedWidthColumns prints one line with every column but the last padded to its width, separated
+// by the same padding as newTableWriter
+func printFixedWidthColumns(columns []string, widths []int) {
+	var line strings.Builder
+	for i, column := range columns {
+		line.WriteString(column)
+		if i < len(columns)-1 {
+			line.WriteString(strings.Repeat(" ", max(widths[i]-utf8.RuneCountInString(column), 0)+2))
+		}
+	}
+	fmt.Println(line.String())
+}
@@ -0,0 +1,361 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func testExchange(timestamp string, method string, uri string, status int, timeTaken time.Duration) actuator.HTTPExchange {
+	return actuator.HTTPExchange{
+		Timestamp: timestamp,
+		Request:   actuator.HTTPExchangeRequest{URI: uri, Method: method},
+		Response:  actuator.HTTPExchangeResponse{Status: status},
+		TimeTaken: actuator.Duration{Duration: timeTaken},
+	}
+}
+
+func TestHTTPExchangesValidation(t *testing.T) {
+	tests := []struct {
+		name         string
+		pods         []string
+		output       string
+		statusFilter string
+		pathFilter   string
+		minDuration  time.Duration
+		interval     time.Duration
+		wantErr      bool
+		errContains  string
+	}{
+		{
+			name:     "no filters",
+			pods:     []string{"pod-1"},
+			interval: time.Second,
+			wantErr:  false,
+		},
+		{
+			name:         "status class",
+			pods:         []string{"pod-1"},
+			statusFilter: "5xx",
+			interval:     time.Second,
+			wantErr:      false,
+		},
+		{
+			name:         "uppercase status class",
+			pods:         []string{"pod-1"},
+			statusFilter: "4XX",
+			interval:     time.Second,
+			wantErr:      false,
+		},
+		{
+			name:         "exact status code",
+			pods:         []string{"pod-1"},
+			statusFilter: "404",
+			interval:     time.Second,
+			wantErr:      false,
+		},
+		{
+			name:         "invalid status class",
+			pods:         []string{"pod-1"},
+			statusFilter: "6xx",
+			interval:     time.Second,
+			wantErr:      true,
+			errContains:  "invalid status filter '6xx'",
+		},
+		{
+			name:         "invalid status text",
+			pods:         []string{"pod-1"},
+			statusFilter: "error",
+			interval:     time.Second,
+			wantErr:      true,
+			errContains:  "invalid status filter",
+		},
+		{
+			name:        "negative min duration",
+			pods:        []string{"pod-1"},
+			minDuration: -time.Second,
+			interval:    time.Second,
+			wantErr:     true,
+			errContains: "--min-duration must not be negative",
+		},
+		{
+			name:        "zero interval",
+			pods:        []string{"pod-1"},
+			wantErr:     true,
+			errContains: "--interval must be positive",
+		},
+		{
+			name:        "invalid output format",
+			pods:        []string{"pod-1"},
+			output:      "json",
+			interval:    time.Second,
+			wantErr:     true,
+			errContains: "output format \"json\" not recognized",
+		},
+		{
+			name:        "no pods",
+			pods:        []string{},
+			interval:    time.Second,
+			wantErr:     true,
+			errContains: "no pods selected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &httpExchangesCommandOperations{
+				baseOperations: baseOperations{pods: tt.pods},
+				output:         tt.output,
+				statusFilter:   tt.statusFilter,
+				pathFilter:     tt.pathFilter,
+				minDuration:    tt.minDuration,
+				interval:       tt.interval,
+			}
+
+			err := ops.validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("validate() error = %v, want error containing %q", err, tt.errContains)
+			}
+		})
+	}
+}
+
+func TestFilterExchanges(t *testing.T) {
+	exchanges := []actuator.HTTPExchange{
+		testExchange("2024-01-15T10:30:00Z", "GET", "http://localhost:8080/api/orders?page=1", 200, 20*time.Millisecond),
+		testExchange("2024-01-15T10:30:01Z", "POST", "http://localhost:8080/api/orders", 500, 800*time.Millisecond),
+		testExchange("2024-01-15T10:30:02Z", "GET", "http://localhost:8080/actuator/health", 503, 5*time.Millisecond),
+		testExchange("2024-01-15T10:30:03Z", "GET", "http://localhost:8080/api/users/1", 404, 2*time.Second),
+	}
+
+	tests := []struct {
+		name         string
+		statusFilter string
+		pathFilter   string
+		minDuration  time.Duration
+		wantURIs     []string
+	}{
+		{
+			name: "no filters",
+			wantURIs: []string{
+				"http://localhost:8080/api/orders?page=1",
+				"http://localhost:8080/api/orders",
+				"http://localhost:8080/actuator/health",
+				"http://localhost:8080/api/users/1",
+			},
+		},
+		{
+			name:         "status class",
+			statusFilter: "5xx",
+			wantURIs:     []string{"http://localhost:8080/api/orders", "http://localhost:8080/actuator/health"},
+		},
+		{
+			name:         "exact status",
+			statusFilter: "404",
+			wantURIs:     []string{"http://localhost:8080/api/users/1"},
+		},
+		{
+			name:       "path glob ignores query string",
+			pathFilter: "/api/orders",
+			wantURIs:   []string{"http://localhost:8080/api/orders?page=1", "http://localhost:8080/api/orders"},
+		},
+		{
+			name:       "path glob with wildcard",
+			pathFilter: "/api/*",
+			wantURIs: []string{
+				"http://localhost:8080/api/orders?page=1",
+				"http://localhost:8080/api/orders",
+				"http://localhost:8080/api/users/1",
+			},
+		},
+		{
+			name:        "min duration",
+			minDuration: 500 * time.Millisecond,
+			wantURIs:    []string{"http://localhost:8080/api/orders", "http://localhost:8080/api/users/1"},
+		},
+		{
+			name:         "combined filters",
+			statusFilter: "5xx",
+			pathFilter:   "/api/*",
+			minDuration:  100 * time.Millisecond,
+			wantURIs:     []string{"http://localhost:8080/api/orders"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &httpExchangesCommandOperations{statusFilter: tt.statusFilter, minDuration: tt.minDuration}
+			if tt.pathFilter != "" {
+				ops.pathPattern = globToRegexp(tt.pathFilter)
+			}
+
+			filtered := ops.filterExchanges(exchanges)
+
+			var gotURIs []string
+			for _, exchange := range filtered {
+				gotURIs = append(gotURIs, exchange.Request.URI)
+			}
+			if strings.Join(gotURIs, " ") != strings.Join(tt.wantURIs, " ") {
+				t.Errorf("filterExchanges() = %v, want %v", gotURIs, tt.wantURIs)
+			}
+		})
+	}
+}
+
+func TestNewExchanges(t *testing.T) {
+	first := testExchange("2024-01-15T10:30:00Z", "GET", "http://localhost:8080/a", 200, 0)
+	second := testExchange("2024-01-15T10:30:01Z", "GET", "http://localhost:8080/b", 200, 0)
+	third := testExchange("2024-01-15T10:30:02Z", "GET", "http://localhost:8080/a", 200, 0)
+
+	fresh, seen := newExchanges([]actuator.HTTPExchange{second, first}, nil)
+	if len(fresh) != 2 {
+		t.Fatalf("first poll: expected 2 new exchanges, got %d", len(fresh))
+	}
+
+	// The repository returned the same exchanges plus a new one with a known URI but a new timestamp
+	fresh, seen = newExchanges([]actuator.HTTPExchange{third, second, first}, seen)
+	if len(fresh) != 1 || fresh[0].Timestamp != third.Timestamp {
+		t.Fatalf("second poll: expected only the third exchange, got %+v", fresh)
+	}
+
+	// The first exchange was evicted from the repository, so it is no longer tracked
+	fresh, seen = newExchanges([]actuator.HTTPExchange{third, second}, seen)
+	if len(fresh) != 0 {
+		t.Errorf("third poll: expected no new exchanges, got %+v", fresh)
+	}
+	if len(seen) != 2 {
+		t.Errorf("expected 2 tracked exchanges, got %d", len(seen))
+	}
+}
+
+func TestWithoutPollingRequests(t *testing.T) {
+	ops := &httpExchangesCommandOperations{endpoint: "httpexchanges"}
+	exchanges := []actuator.HTTPExchange{
+		testExchange("2024-01-15T10:30:00Z", "GET", "http://localhost:8080/actuator/httpexchanges", 200, 0),
+		testExchange("2024-01-15T10:30:01Z", "GET", "http://localhost:8080/api/orders", 200, 0),
+	}
+
+	filtered := ops.withoutPollingRequests(exchanges)
+	if len(filtered) != 1 || filtered[0].Request.URI != "http://localhost:8080/api/orders" {
+		t.Errorf("withoutPollingRequests() = %+v, want only /api/orders", filtered)
+	}
+}
+
+func TestMatchesStatus(t *testing.T) {
+	tests := []struct {
+		status int
+		filter string
+		want   bool
+	}{
+		{200, "2xx", true},
+		{204, "2xx", true},
+		{301, "2xx", false},
+		{500, "5xx", true},
+		{404, "404", true},
+		{404, "400", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			if got := matchesStatus(tt.status, tt.filter); got != tt.want {
+				t.Errorf("matchesStatus(%d, %q) = %v, want %v", tt.status, tt.filter, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisplayExchangesTable(t *testing.T) {
+	exchanges := []actuator.HTTPExchange{
+		testExchange("2024-01-15T10:30:05Z", "POST", "http://localhost:8080/api/orders", 201, 1500*time.Millisecond),
+		testExchange("2024-01-15T10:30:00Z", "GET", "http://localhost:8080/api/orders?page=2", 200, 23*time.Millisecond),
+	}
+	exchanges[1].Request.RemoteAddress = "10.0.0.1"
+	exchanges[1].Principal = &actuator.HTTPExchangePrincipal{Name: "alice"}
+
+	tests := []struct {
+		name        string
+		pod         string
+		showPod     bool
+		wideMode    bool
+		expected    []string
+		notExpected []string
+		pattern     string
+	}{
+		{
+			name:        "default columns",
+			expected:    []string{"/api/orders?page=2", "23ms", "1.50s"},
+			notExpected: []string{"POD", "REMOTE ADDRESS", "http://localhost:8080"},
+			pattern:     `(?s)TIMESTAMP\s+METHOD\s+URI\s+STATUS\s+TIME\n.*GET\s+/api/orders\?page=2\s+200.*\n.*POST\s+/api/orders\s+201`,
+		},
+		{
+			name:     "wide columns",
+			wideMode: true,
+			expected: []string{"REMOTE ADDRESS", "PRINCIPAL", "http://localhost:8080/api/orders?page=2", "10.0.0.1", "alice"},
+		},
+		{
+			name:     "pod column",
+			pod:      "pod-1",
+			showPod:  true,
+			expected: []string{"POD", "pod-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := captureOutput(func() {
+				displayExchangesTable(exchangeRows(tt.pod, exchanges), tt.showPod, tt.wideMode)
+			})
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("displayExchangesTable() output missing expected value:\n  want: %s\n  got:\n%s", expected, output)
+				}
+			}
+			for _, notExpected := range tt.notExpected {
+				if strings.Contains(output, notExpected) {
+					t.Errorf("displayExchangesTable() output contains unexpected value:\n  unwanted: %s\n  got:\n%s", notExpected, output)
+				}
+			}
+			if tt.pattern != "" && !regexp.MustCompile(tt.pattern).MatchString(output) {
+				t.Errorf("displayExchangesTable() output does not match %q:\n%s", tt.pattern, output)
+			}
+		})
+	}
+}
+
+func TestFollowColumnsStayAligned(t *testing.T) {
+	pods := []string{"my-app-abc12", "my-app-def34"}
+	widths := followColumnWidths(pods, true, false)
+
+	// Each poll prints its own batch, the second one with longer values than the first
+	first := testExchange("2024-01-15T10:30:00Z", "GET", "http://localhost:8080/api", 200, 5*time.Millisecond)
+	second := testExchange("2024-01-15T10:30:02Z", "DELETE", "http://localhost:8080/api/orders/12345", 204, 1500*time.Millisecond)
+	output := captureOutput(func() {
+		printFixedWidthColumns(exchangeHeader(true, false), widths)
+		printFixedWidthColumns(exchangeColumns(exchangeRows(pods[0], []actuator.HTTPExchange{first})[0], true, false), widths)
+		printFixedWidthColumns(exchangeColumns(exchangeRows(pods[1], []actuator.HTTPExchange{second})[0], true, false), widths)
+	})
+
+	lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected a header and two rows, got:\n%s", output)
+	}
+	for _, column := range []struct{ header, first, second string }{
+		{"METHOD", "GET", "DELETE"},
+		{"URI", "/api", "/api/orders/12345"},
+		{"STATUS", "200", "204"},
+		{"TIME", "5ms", "1.50s"},
+	} {
+		start := strings.LastIndex(lines[0], column.header)
+		if strings.LastIndex(lines[1], column.first) != start || strings.LastIndex(lines[2], column.second) != start {
+			t.Errorf("column %s is not aligned:\n%s", column.header, output)
+		}
+	}
+}
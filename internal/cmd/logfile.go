@@ -0,0 +1,232 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const defaultLogFilePollInterval = 2 * time.Second
+
+type logFileCommandOperations struct {
+	baseOperations
+	tailBytes int64
+	follow    bool
+	interval  time.Duration
+	out       io.Writer
+}
+
+func NewLogFileCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &logFileCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+		out: os.Stdout,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "logfile",
+		Short: "Print the application log file",
+		Long: `Print the log file of the application via Spring Boot Actuator.
+
+Requires the application to log to a file (logging.file.name or
+logging.file.path). Use --tail-bytes to only fetch the end of the file.
+
+With --follow, the endpoint is polled for content appended since the last
+poll. If the file shrinks, e.g. because it was rotated, it is read again
+from the start. When following several pods, their lines are interleaved
+and prefixed with the pod name.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			if operations.follow {
+				return operations.runFollow(cmd.Context())
+			}
+			return RunForEachPod(cmd.Context(), operations.pods, "get log file", operations.runForPod)
+		},
+	}
+
+	cmd.Flags().Int64Var(&operations.tailBytes, "tail-bytes", 0, "Only print the last N bytes of the log file")
+	cmd.Flags().BoolVarP(&operations.follow, "follow", "f", false, "Poll for new log output until interrupted")
+	cmd.Flags().DurationVar(&operations.interval, "interval", defaultLogFilePollInterval, "Polling interval for --follow")
+
+	return cmd
+}
+
+func (o *logFileCommandOperations) validate() error {
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+
+	if o.tailBytes < 0 {
+		return fmt.Errorf("--tail-bytes must not be negative")
+	}
+
+	if o.interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+
+	return nil
+}
+
+func (o *logFileCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	chunk, err := client.GetLogFile(actuator.LogFileRange{Tail: o.tailBytes})
+	if err != nil {
+		return err
+	}
+
+	_, _ = o.out.Write(chunk.Content)
+	if len(chunk.Content) > 0 && !bytes.HasSuffix(chunk.Content, []byte("\n")) {
+		_, _ = fmt.Fprintln(o.out)
+	}
+	return nil
+}
+
+// runFollow polls the log file of every pod until the context is cancelled
+func (o *logFileCommandOperations) runFollow(ctx context.Context) error {
+	showPrefix := len(o.pods) > 1
+
+	var followers []*logFileFollower
+	for _, pod := range o.pods {
+		client, err := o.actuatorClientFactory.NewClient(ctx, pod)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %s: %v\n", pod, err)
+			continue
+		}
+
+		prefix := ""
+		if showPrefix {
+			prefix = "[" + pod + "] "
+		}
+		followers = append(followers, &logFileFollower{
+			pod:       pod,
+			fetch:     client.GetLogFile,
+			tailBytes: o.tailBytes,
+			out:       &prefixWriter{out: o.out, prefix: prefix},
+		})
+	}
+	if len(followers) == 0 {
+		return fmt.Errorf("follow failed on %d pod(s)", len(o.pods))
+	}
+
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	for {
+		for _, follower := range followers {
+			if err := follower.poll(); err != nil {
+				if ctx.Err() != nil {
+					break
+				}
+				_, _ = fmt.Fprintf(os.Stderr, "Error: %s: %v\n", follower.pod, err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			for _, follower := range followers {
+				follower.out.Flush()
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// logFileFollower tracks how much of the log file of a pod has been printed
+type logFileFollower struct {
+	pod       string
+	fetch     func(byteRange actuator.LogFileRange) (*actuator.LogFileChunk, error)
+	tailBytes int64
+	started   bool
+	offset    int64
+	out       *prefixWriter
+}
+
+// poll prints the content appended to the log file since the last poll
+func (f *logFileFollower) poll() error {
+	byteRange := actuator.LogFileRange{Offset: f.offset}
+	if !f.started {
+		byteRange = actuator.LogFileRange{Tail: f.tailBytes}
+	}
+
+	chunk, err := f.fetch(byteRange)
+	if err != nil {
+		return err
+	}
+	f.started = true
+
+	if chunk.Size >= 0 && chunk.Size < f.offset {
+		_, _ = fmt.Fprintf(os.Stderr, "%s: log file was truncated, reading from the start\n", f.pod)
+		f.offset = 0
+		return f.poll()
+	}
+
+	content := chunk.Content
+	// A server that ignores the Range header returns the whole file
+	if skip := f.offset - chunk.Offset; skip > 0 {
+		if skip > int64(len(content)) {
+			skip = int64(len(content))
+		}
+		content = content[skip:]
+	}
+
+	_, _ = f.out.Write(content)
+	f.offset = max(f.offset, chunk.Offset+int64(len(chunk.Content)))
+	return nil
+}
+
+// prefixWriter prefixes every line written to it. Incomplete lines are held back until they are
+// completed, so that lines of several pods can be interleaved.
+type prefixWriter struct {
+	out     io.Writer
+	prefix  string
+	partial []byte
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	if w.prefix == "" {
+		return w.out.Write(p)
+	}
+
+	data := append(w.partial, p...)
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		if _, err := fmt.Fprintf(w.out, "%s%s\n", w.prefix, data[:i]); err != nil {
+			return 0, err
+		}
+		data = data[i+1:]
+	}
+	w.partial = bytes.Clone(data)
+
+	return len(p), nil
+}
+
+// Flush writes a held back incomplete line
+func (w *prefixWriter) Flush() {
+	if len(w.partial) > 0 {
+		_, _ = fmt.Fprintf(w.out, "%s%s\n", w.prefix, w.partial)
+		w.partial = nil
+	}
+}
@@ -0,0 +1,233 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func TestLogFileValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		pods        []string
+		tailBytes   int64
+		interval    time.Duration
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "defaults",
+			pods:     []string{"pod-1"},
+			interval: time.Second,
+			wantErr:  false,
+		},
+		{
+			name:      "tail bytes",
+			pods:      []string{"pod-1"},
+			tailBytes: 4096,
+			interval:  time.Second,
+			wantErr:   false,
+		},
+		{
+			name:        "negative tail bytes",
+			pods:        []string{"pod-1"},
+			tailBytes:   -1,
+			interval:    time.Second,
+			wantErr:     true,
+			errContains: "--tail-bytes must not be negative",
+		},
+		{
+			name:        "zero interval",
+			pods:        []string{"pod-1"},
+			interval:    0,
+			wantErr:     true,
+			errContains: "--interval must be positive",
+		},
+		{
+			name:        "no pods",
+			pods:        []string{},
+			interval:    time.Second,
+			wantErr:     true,
+			errContains: "no pods selected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &logFileCommandOperations{
+				baseOperations: baseOperations{pods: tt.pods},
+				tailBytes:      tt.tailBytes,
+				interval:       tt.interval,
+			}
+
+			err := ops.validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("validate() error = %v, want error containing %q", err, tt.errContains)
+			}
+		})
+	}
+}
+
+// fakeLogFile serves a log file like the logfile endpoint, with or without Range support
+type fakeLogFile struct {
+	content       []byte
+	supportsRange bool
+	requests      []actuator.LogFileRange
+}
+
+func (f *fakeLogFile) fetch(byteRange actuator.LogFileRange) (*actuator.LogFileChunk, error) {
+	f.requests = append(f.requests, byteRange)
+	size := int64(len(f.content))
+
+	if !f.supportsRange || (byteRange.Tail == 0 && byteRange.Offset == 0) {
+		return &actuator.LogFileChunk{Content: bytes.Clone(f.content), Offset: 0, Size: size}, nil
+	}
+
+	if byteRange.Tail > 0 {
+		start := max(size-byteRange.Tail, 0)
+		return &actuator.LogFileChunk{Content: bytes.Clone(f.content[start:]), Offset: start, Size: size}, nil
+	}
+
+	if byteRange.Offset >= size {
+		return &actuator.LogFileChunk{Offset: byteRange.Offset, Size: size}, nil
+	}
+	return &actuator.LogFileChunk{Content: bytes.Clone(f.content[byteRange.Offset:]), Offset: byteRange.Offset, Size: size}, nil
+}
+
+func TestLogFileFollowerPoll(t *testing.T) {
+	tests := []struct {
+		name          string
+		supportsRange bool
+		tailBytes     int64
+		// updates are applied to the file before each poll after the first one
+		initial  string
+		updates  []string
+		expected string
+	}{
+		{
+			name:          "appended content",
+			supportsRange: true,
+			initial:       "line 1\n",
+			updates:       []string{"line 1\nline 2\n", "line 1\nline 2\n", "line 1\nline 2\nline 3\n"},
+			expected:      "line 1\nline 2\nline 3\n",
+		},
+		{
+			name:          "tail on first poll",
+			supportsRange: true,
+			tailBytes:     7,
+			initial:       "line 1\nline 2\n",
+			updates:       []string{"line 1\nline 2\nline 3\n"},
+			expected:      "line 2\nline 3\n",
+		},
+		{
+			name:          "truncated file is read from the start",
+			supportsRange: true,
+			initial:       "line 1\nline 2\n",
+			updates:       []string{"new 1\n"},
+			expected:      "line 1\nline 2\nnew 1\n",
+		},
+		{
+			name:          "range not supported",
+			supportsRange: false,
+			initial:       "line 1\n",
+			updates:       []string{"line 1\nline 2\n", "line 1\nline 2\n"},
+			expected:      "line 1\nline 2\n",
+		},
+		{
+			name:          "range not supported and truncated",
+			supportsRange: false,
+			initial:       "line 1\nline 2\n",
+			updates:       []string{"new 1\n"},
+			expected:      "line 1\nline 2\nnew 1\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := &fakeLogFile{content: []byte(tt.initial), supportsRange: tt.supportsRange}
+			var out bytes.Buffer
+			follower := &logFileFollower{
+				pod:       "pod-1",
+				fetch:     file.fetch,
+				tailBytes: tt.tailBytes,
+				out:       &prefixWriter{out: &out},
+			}
+
+			if err := follower.poll(); err != nil {
+				t.Fatalf("poll() error = %v", err)
+			}
+			for _, update := range tt.updates {
+				file.content = []byte(update)
+				if err := follower.poll(); err != nil {
+					t.Fatalf("poll() error = %v", err)
+				}
+			}
+
+			if out.String() != tt.expected {
+				t.Errorf("output = %q, want %q", out.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestLogFileFollowerRequestsOffset(t *testing.T) {
+	file := &fakeLogFile{content: []byte("line 1\n"), supportsRange: true}
+	follower := &logFileFollower{
+		fetch:     file.fetch,
+		tailBytes: 100,
+		out:       &prefixWriter{out: &bytes.Buffer{}},
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := follower.poll(); err != nil {
+			t.Fatalf("poll() error = %v", err)
+		}
+	}
+
+	expected := []actuator.LogFileRange{{Tail: 100}, {Offset: 7}}
+	if len(file.requests) != len(expected) {
+		t.Fatalf("requests = %v, want %v", file.requests, expected)
+	}
+	for i, request := range expected {
+		if file.requests[i] != request {
+			t.Errorf("request %d = %+v, want %+v", i, file.requests[i], request)
+		}
+	}
+}
+
+func TestPrefixWriter(t *testing.T) {
+	var out bytes.Buffer
+	first := &prefixWriter{out: &out, prefix: "[pod-1] "}
+	second := &prefixWriter{out: &out, prefix: "[pod-2] "}
+
+	_, _ = first.Write([]byte("a1\na2 start"))
+	_, _ = second.Write([]byte("b1\n"))
+	_, _ = first.Write([]byte(" end\n"))
+	_, _ = second.Write([]byte("b2"))
+	second.Flush()
+	first.Flush()
+
+	expected := "[pod-1] a1\n[pod-2] b1\n[pod-1] a2 start end\n[pod-2] b2\n"
+	if out.String() != expected {
+		t.Errorf("output = %q, want %q", out.String(), expected)
+	}
+}
+
+func TestPrefixWriterWithoutPrefix(t *testing.T) {
+	var out bytes.Buffer
+	w := &prefixWriter{out: &out}
+
+	_, _ = w.Write([]byte("partial"))
+	w.Flush()
+
+	if out.String() != "partial" {
+		t.Errorf("output = %q, want %q", out.String(), "partial")
+	}
+}
@@ -0,0 +1,314 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const (
+	maxHandlerLength    = 80
+	maxMediaTypesLength = 40
+	anyMethod           = "*"
+)
+
+var validHTTPMethods = []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS", "TRACE"}
+
+type mappingsCommandOperations struct {
+	baseOperations
+	output       string
+	methodFilter string
+	pathFilter   string
+	pathPattern  *regexp.Regexp
+}
+
+func NewMappingsCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &mappingsCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "mappings",
+		Short: "Get request mappings",
+		Long: `Get request mappings from Spring Boot Actuator.
+
+Lists the routes of Spring MVC dispatcher servlets and WebFlux dispatcher
+handlers, together with the registered servlets and servlet filters. Filters
+registered for servlets by name are shown with the paths of these servlets.
+
+Mappings without a method restriction match every --method filter.
+The --path filter is a glob pattern where '*' matches any sequence of
+characters (including '/') and '?' matches a single character.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			return RunForEachPod(cmd.Context(), operations.pods, "get mappings", operations.runForPod)
+		},
+	}
+
+	cmd.Flags().StringVarP(&operations.output, "output", "o", "", "Output format. One of: wide")
+	cmd.Flags().StringVar(&operations.methodFilter, "method", "", "Filter by HTTP method (e.g., GET, POST)")
+	cmd.Flags().StringVar(&operations.pathFilter, "path", "", "Filter by path pattern glob (e.g., /api/*)")
+
+	return cmd
+}
+
+func (o *mappingsCommandOperations) validate() error {
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+
+	if err := validateOutputFormat(o.output, OutputFormatWide); err != nil {
+		return err
+	}
+
+	if o.methodFilter != "" {
+		o.methodFilter = strings.ToUpper(o.methodFilter)
+		if !slices.Contains(validHTTPMethods, o.methodFilter) {
+			return fmt.Errorf("invalid HTTP method '%s'\nValid methods: %v", o.methodFilter, validHTTPMethods)
+		}
+	}
+
+	if o.pathFilter != "" {
+		o.pathPattern = globToRegexp(o.pathFilter)
+	}
+
+	return nil
+}
+
+func (o *mappingsCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	mappings, err := client.GetMappings()
+	if err != nil {
+		return err
+	}
+
+	rows := o.filterMappings(collectMappings(mappings))
+	displayMappingsTable(rows, o.output == OutputFormatWide)
+	return nil
+}
+
+type mappingRow struct {
+	kind     string
+	source   string
+	methods  []string
+	path     string
+	handler  string
+	produces string
+	consumes string
+}
+
+func (r mappingRow) method() string {
+	if len(r.methods) == 0 {
+		return anyMethod
+	}
+	return strings.Join(r.methods, ",")
+}
+
+func collectMappings(mappings *actuator.MappingsResponse) []mappingRow {
+	var rows []mappingRow
+
+	for _, appCtx := range mappings.Contexts {
+		for name, dispatcherMappings := range appCtx.Mappings.DispatcherServlets {
+			rows = append(rows, collectDispatcherMappings("mvc", name, dispatcherMappings)...)
+		}
+		for name, dispatcherMappings := range appCtx.Mappings.DispatcherHandlers {
+			rows = append(rows, collectDispatcherMappings("webflux", name, dispatcherMappings)...)
+		}
+		for _, servlet := range appCtx.Mappings.Servlets {
+			for _, path := range servlet.Mappings {
+				rows = append(rows, mappingRow{
+					kind:    "servlet",
+					source:  servlet.Name,
+					path:    path,
+					handler: servlet.ClassName,
+				})
+			}
+		}
+		servletPaths := make(map[string][]string, len(appCtx.Mappings.Servlets))
+		for _, servlet := range appCtx.Mappings.Servlets {
+			servletPaths[servlet.Name] = servlet.Mappings
+		}
+		for _, filter := range appCtx.Mappings.ServletFilters {
+			// Filters registered for servlets by name apply to the paths of these servlets. Filters without
+			// any known path are still listed, so the filters are complete.
+			paths := slices.Clone(filter.URLPatternMappings)
+			for _, servletName := range filter.ServletNameMappings {
+				paths = append(paths, servletPaths[servletName]...)
+			}
+			if len(paths) == 0 {
+				paths = []string{"-"}
+			}
+			for _, path := range paths {
+				rows = append(rows, mappingRow{
+					kind:    "filter",
+					source:  filter.Name,
+					path:    path,
+					handler: filter.ClassName,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].path == rows[j].path {
+			return rows[i].method() < rows[j].method()
+		}
+		return rows[i].path < rows[j].path
+	})
+
+	return rows
+}
+
+func collectDispatcherMappings(kind string, source string, mappings []actuator.DispatcherMapping) []mappingRow {
+	var rows []mappingRow
+
+	for _, mapping := range mappings {
+		handler := mapping.Handler
+		var conditions *actuator.RequestMappingConditions
+
+		if mapping.Details != nil {
+			conditions = mapping.Details.RequestMappingConditions
+			switch {
+			case mapping.Details.HandlerMethod != nil:
+				handler = mapping.Details.HandlerMethod.ClassName + "#" + mapping.Details.HandlerMethod.Name
+			case mapping.Details.HandlerFunction != nil:
+				handler = mapping.Details.HandlerFunction.ClassName
+			}
+		}
+
+		// Mappings without request conditions (e.g. resource handlers) only expose their predicate
+		if conditions == nil || len(conditions.Patterns) == 0 {
+			row := mappingRow{kind: kind, source: source, path: mapping.Predicate, handler: handler}
+			if conditions != nil {
+				row.methods = conditions.Methods
+				row.produces = formatMediaTypes(conditions.Produces)
+				row.consumes = formatMediaTypes(conditions.Consumes)
+			}
+			rows = append(rows, row)
+			continue
+		}
+
+		for _, pattern := range conditions.Patterns {
+			rows = append(rows, mappingRow{
+				kind:     kind,
+				source:   source,
+				methods:  conditions.Methods,
+				path:     pattern,
+				handler:  handler,
+				produces: formatMediaTypes(conditions.Produces),
+				consumes: formatMediaTypes(conditions.Consumes),
+			})
+		}
+	}
+
+	return rows
+}
+
+func formatMediaTypes(mediaTypes []actuator.MediaTypeExpression) string {
+	formatted := make([]string, 0, len(mediaTypes))
+	for _, mediaType := range mediaTypes {
+		if mediaType.Negated {
+			formatted = append(formatted, "!"+mediaType.MediaType)
+		} else {
+			formatted = append(formatted, mediaType.MediaType)
+		}
+	}
+	return strings.Join(formatted, ",")
+}
+
+func (o *mappingsCommandOperations) filterMappings(rows []mappingRow) []mappingRow {
+	var filtered []mappingRow
+	for _, row := range rows {
+		if o.methodFilter != "" && len(row.methods) > 0 && !slices.Contains(row.methods, o.methodFilter) {
+			continue
+		}
+		if o.pathPattern != nil && !o.pathPattern.MatchString(row.path) {
+			continue
+		}
+		filtered = append(filtered, row)
+	}
+	return filtered
+}
+
+func displayMappingsTable(rows []mappingRow, wideMode bool) {
+	if len(rows) == 0 {
+		fmt.Println("No mappings found")
+		return
+	}
+
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	if wideMode {
+		_, _ = fmt.Fprintln(w, "TYPE\tSOURCE\tMETHOD\tPATH\tHANDLER\tPRODUCES\tCONSUMES")
+	} else {
+		_, _ = fmt.Fprintln(w, "METHOD\tPATH\tHANDLER\tPRODUCES\tCONSUMES")
+	}
+
+	for _, row := range rows {
+		if wideMode {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				row.kind, row.source, row.method(), row.path, row.handler,
+				valueOrDash(row.produces), valueOrDash(row.consumes))
+		} else {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", row.method(), row.path, shortenHandler(row.handler),
+				valueOrDash(truncateString(row.produces, maxMediaTypesLength)),
+				valueOrDash(truncateString(row.consumes, maxMediaTypesLength)))
+		}
+	}
+}
+
+// shortenHandler abbreviates the package of class-based handlers. Descriptive handlers
+// such as "Actuator web endpoint 'health'" are only truncated.
+func shortenHandler(handler string) string {
+	if strings.ContainsAny(handler, " \t") {
+		return truncateString(handler, maxHandlerLength)
+	}
+	return shortenType(handler, maxHandlerLength)
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// globToRegexp converts a glob pattern into an anchored regular expression.
+// '*' matches any sequence of characters and '?' matches a single character.
+func globToRegexp(glob string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
@@ -0,0 +1,283 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func testMappingsResponse() *actuator.MappingsResponse {
+	return &actuator.MappingsResponse{
+		Contexts: map[string]actuator.MappingsContext{
+			"application": {
+				Mappings: actuator.ContextMappings{
+					DispatcherServlets: map[string][]actuator.DispatcherMapping{
+						"dispatcherServlet": {
+							{
+								Handler:   "com.example.OrderController#list()",
+								Predicate: "{GET [/api/orders]}",
+								Details: &actuator.DispatcherMappingDetails{
+									HandlerMethod: &actuator.HandlerMethod{ClassName: "com.example.OrderController", Name: "list"},
+									RequestMappingConditions: &actuator.RequestMappingConditions{
+										Methods:  []string{"GET"},
+										Patterns: []string{"/api/orders"},
+										Produces: []actuator.MediaTypeExpression{{MediaType: "application/json"}},
+									},
+								},
+							},
+							{
+								Handler:   "com.example.OrderController#create(Order)",
+								Predicate: "{POST [/api/orders]}",
+								Details: &actuator.DispatcherMappingDetails{
+									HandlerMethod: &actuator.HandlerMethod{ClassName: "com.example.OrderController", Name: "create"},
+									RequestMappingConditions: &actuator.RequestMappingConditions{
+										Methods:  []string{"POST"},
+										Patterns: []string{"/api/orders"},
+										Consumes: []actuator.MediaTypeExpression{{MediaType: "application/json"}, {MediaType: "text/plain", Negated: true}},
+									},
+								},
+							},
+							{
+								Handler:   "ResourceHttpRequestHandler [classpath [META-INF/resources/webjars/]]",
+								Predicate: "/webjars/**",
+							},
+						},
+					},
+					Servlets: []actuator.ServletMapping{
+						{Name: "dispatcherServlet", ClassName: "org.springframework.web.servlet.DispatcherServlet", Mappings: []string{"/"}},
+					},
+					ServletFilters: []actuator.ServletFilterMapping{
+						{Name: "characterEncodingFilter", ClassName: "org.springframework.boot.web.servlet.filter.OrderedCharacterEncodingFilter", URLPatternMappings: []string{"/*"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCollectMappings(t *testing.T) {
+	rows := collectMappings(testMappingsResponse())
+
+	if len(rows) != 5 {
+		t.Fatalf("expected 5 rows, got %d", len(rows))
+	}
+
+	wantOrder := []string{"* /", "* /*", "GET /api/orders", "POST /api/orders", "* /webjars/**"}
+	for i, row := range rows {
+		if got := row.method() + " " + row.path; got != wantOrder[i] {
+			t.Errorf("row %d = %q, want %q", i, got, wantOrder[i])
+		}
+	}
+
+	for _, row := range rows {
+		switch row.path {
+		case "/":
+			if row.kind != "servlet" || row.source != "dispatcherServlet" {
+				t.Errorf("unexpected servlet row: %+v", row)
+			}
+		case "/*":
+			if row.kind != "filter" || row.source != "characterEncodingFilter" {
+				t.Errorf("unexpected filter row: %+v", row)
+			}
+		case "/webjars/**":
+			if row.kind != "mvc" || !strings.HasPrefix(row.handler, "ResourceHttpRequestHandler") {
+				t.Errorf("unexpected resource handler row: %+v", row)
+			}
+		}
+		if row.method() == "GET" && row.handler != "com.example.OrderController#list" {
+			t.Errorf("expected handler 'com.example.OrderController#list', got '%s'", row.handler)
+		}
+		if row.method() == "POST" && row.consumes != "application/json,!text/plain" {
+			t.Errorf("expected consumes 'application/json,!text/plain', got '%s'", row.consumes)
+		}
+	}
+}
+
+func TestCollectMappingsOfFiltersForServletNames(t *testing.T) {
+	mappings := testMappingsResponse()
+	appCtx := mappings.Contexts["application"]
+	appCtx.Mappings.ServletFilters = append(appCtx.Mappings.ServletFilters,
+		actuator.ServletFilterMapping{Name: "requestContextFilter", ClassName: "org.springframework.web.filter.RequestContextFilter", ServletNameMappings: []string{"dispatcherServlet"}},
+		actuator.ServletFilterMapping{Name: "auditFilter", ClassName: "com.example.AuditFilter", ServletNameMappings: []string{"unknownServlet"}},
+	)
+	mappings.Contexts["application"] = appCtx
+
+	var got []string
+	for _, row := range collectMappings(mappings) {
+		if row.kind == "filter" {
+			got = append(got, row.source+" "+row.path)
+		}
+	}
+
+	want := "auditFilter -,requestContextFilter /,characterEncodingFilter /*"
+	if strings.Join(got, ",") != want {
+		t.Errorf("filter rows = %s, want %s", strings.Join(got, ","), want)
+	}
+}
+
+func TestMappingsFilter(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		wantPaths []string
+	}{
+		{
+			name:      "no filter",
+			wantPaths: []string{"* /", "* /*", "GET /api/orders", "POST /api/orders", "* /webjars/**"},
+		},
+		{
+			name:      "method filter keeps unrestricted mappings",
+			method:    "post",
+			wantPaths: []string{"* /", "* /*", "POST /api/orders", "* /webjars/**"},
+		},
+		{
+			name:      "path glob",
+			path:      "/api/*",
+			wantPaths: []string{"GET /api/orders", "POST /api/orders"},
+		},
+		{
+			name:      "path glob with question mark",
+			path:      "/?",
+			wantPaths: []string{"* /*"},
+		},
+		{
+			name:      "method and path",
+			method:    "GET",
+			path:      "/api/*",
+			wantPaths: []string{"GET /api/orders"},
+		},
+		{
+			name:      "path matches literal special characters",
+			path:      "/webjars/**",
+			wantPaths: []string{"* /webjars/**"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &mappingsCommandOperations{
+				baseOperations: baseOperations{pods: []string{"pod-1"}},
+				methodFilter:   tt.method,
+				pathFilter:     tt.path,
+			}
+			if err := ops.validate(); err != nil {
+				t.Fatalf("validate() error = %v", err)
+			}
+
+			rows := ops.filterMappings(collectMappings(testMappingsResponse()))
+
+			var got []string
+			for _, row := range rows {
+				got = append(got, row.method()+" "+row.path)
+			}
+			if strings.Join(got, "|") != strings.Join(tt.wantPaths, "|") {
+				t.Errorf("filtered rows = %v, want %v", got, tt.wantPaths)
+			}
+		})
+	}
+}
+
+func TestMappingsValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		pods        []string
+		output      string
+		method      string
+		wantErr     bool
+		errContains string
+	}{
+		{"valid default", []string{"pod-1"}, "", "", false, ""},
+		{"valid wide", []string{"pod-1"}, OutputFormatWide, "", false, ""},
+		{"valid method lowercase", []string{"pod-1"}, "", "delete", false, ""},
+		{"invalid method", []string{"pod-1"}, "", "FETCH", true, "invalid HTTP method"},
+		{"invalid output", []string{"pod-1"}, "json", "", true, "not recognized"},
+		{"no pods", []string{}, "", "", true, "no pods selected"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &mappingsCommandOperations{
+				baseOperations: baseOperations{pods: tt.pods},
+				output:         tt.output,
+				methodFilter:   tt.method,
+			}
+
+			err := ops.validate()
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("expected error containing '%s', got '%v'", tt.errContains, err)
+				}
+			}
+		})
+	}
+}
+
+func TestDisplayMappingsTable(t *testing.T) {
+	rows := collectMappings(testMappingsResponse())
+	actuatorMediaTypes := "application/vnd.spring-boot.actuator.v3+json,application/vnd.spring-boot.actuator.v2+json,application/json"
+
+	tests := []struct {
+		name        string
+		rows        []mappingRow
+		wideMode    bool
+		expected    []string
+		notExpected []string
+	}{
+		{
+			name:        "table output",
+			rows:        rows,
+			expected:    []string{"METHOD", "PATH", "HANDLER", "PRODUCES", "CONSUMES", "c.e.OrderController#list", "ResourceHttpRequestHandler [classpath [META-INF/resources/webjars/]]", "application/json,!text/plain"},
+			notExpected: []string{"TYPE", "SOURCE", "com.example.OrderController"},
+		},
+		{
+			name:     "wide output",
+			rows:     rows,
+			wideMode: true,
+			expected: []string{"TYPE", "SOURCE", "PRODUCES", "CONSUMES", "com.example.OrderController#list", "application/json", "dispatcherServlet", "characterEncodingFilter"},
+		},
+		{
+			name:        "long media types",
+			rows:        []mappingRow{{kind: "mvc", path: "/actuator/health", handler: "Actuator web endpoint 'health'", produces: actuatorMediaTypes}},
+			expected:    []string{"application/vnd.spring-boot.actuator.v3…"},
+			notExpected: []string{actuatorMediaTypes},
+		},
+		{
+			name:     "long media types wide",
+			rows:     []mappingRow{{kind: "mvc", path: "/actuator/health", handler: "Actuator web endpoint 'health'", produces: actuatorMediaTypes}},
+			wideMode: true,
+			expected: []string{actuatorMediaTypes},
+		},
+		{
+			name:     "no mappings",
+			rows:     nil,
+			expected: []string{"No mappings found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := captureOutput(func() {
+				displayMappingsTable(tt.rows, tt.wideMode)
+			})
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("displayMappingsTable() output missing expected value:\n  want: %s\n  got:\n%s", expected, output)
+				}
+			}
+			for _, notExpected := range tt.notExpected {
+				if strings.Contains(output, notExpected) {
+					t.Errorf("displayMappingsTable() output contains unexpected value:\n  unwanted: %s\n  got:\n%s", notExpected, output)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+const (
+	aggregationSum = "sum"
+	aggregationMax = "max"
+)
+
+// runAggregate fetches the metric from every pod and prints one combined result instead of one result per pod
+func (o *metricsCommandOperations) runAggregate(ctx context.Context) error {
+	var metrics []*actuator.MetricResponse
+	var rows []metricBreakdownRow
+	failed := 0
+
+	for _, pod := range o.pods {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		metric, err := o.fetchMetric(ctx, pod)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %s: %v\n", pod, err)
+			failed++
+			continue
+		}
+		metrics = append(metrics, metric)
+		rows = append(rows, metricBreakdownRow{name: pod, measurements: metric.Measurements})
+	}
+
+	if len(metrics) > 0 {
+		aggregated := aggregateMetrics(metrics)
+		displayAggregatedMetric(aggregated, o.tags)
+
+		if o.output == OutputFormatWide {
+			fmt.Println()
+			fmt.Println("PODS")
+			displayMetricBreakdown("POD", aggregated.metric, rows)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("get metrics failed on %d pod(s)", failed)
+	}
+	return nil
+}
+
+func (o *metricsCommandOperations) fetchMetric(ctx context.Context, podName string) (*actuator.MetricResponse, error) {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetMetric(o.metricName, o.tags...)
+}
+
+type aggregatedMetric struct {
+	// metric holds the combined measurements and the available tags of all pods
+	metric *actuator.MetricResponse
+	pods   int
+	rows   []aggregatedMeasurement
+}
+
+type aggregatedMeasurement struct {
+	statistic   string
+	value       float64
+	aggregation string
+}
+
+// aggregateMetrics combines the measurements of the same metric from several pods. Statistics are summed
+// up, except MAX, for which the maximum is used. A MEAN is derived from the combined values.
+func aggregateMetrics(metrics []*actuator.MetricResponse) *aggregatedMetric {
+	combined := &actuator.MetricResponse{
+		Name:        metrics[0].Name,
+		Description: metrics[0].Description,
+		BaseUnit:    metrics[0].BaseUnit,
+	}
+
+	var statistics []string
+	values := make(map[string]float64)
+	reported := make(map[string]int)
+	for _, metric := range metrics {
+		for _, m := range metric.Measurements {
+			current, seen := values[m.Statistic]
+			if !seen {
+				statistics = append(statistics, m.Statistic)
+				values[m.Statistic] = m.Value
+			} else if m.Statistic == "MAX" {
+				values[m.Statistic] = max(current, m.Value)
+			} else {
+				values[m.Statistic] = current + m.Value
+			}
+			reported[m.Statistic]++
+		}
+	}
+
+	result := &aggregatedMetric{metric: combined, pods: len(metrics)}
+	for _, statistic := range statistics {
+		aggregation := aggregationSum
+		if statistic == "MAX" {
+			aggregation = aggregationMax
+		}
+		combined.Measurements = append(combined.Measurements, actuator.Measurement{Statistic: statistic, Value: values[statistic]})
+		result.rows = append(result.rows, aggregatedMeasurement{statistic: statistic, value: values[statistic], aggregation: aggregation})
+	}
+
+	// The mean of timers and distribution summaries is weighted by the number of events of each pod
+	if count, ok := values[statisticCount]; ok && count > 0 {
+		for _, total := range []string{statisticTotalTime, "TOTAL"} {
+			if sum, ok := values[total]; ok {
+				result.rows = append(result.rows, aggregatedMeasurement{
+					statistic:   statisticMean,
+					value:       sum / count,
+					aggregation: total + " / " + statisticCount,
+				})
+				break
+			}
+		}
+	} else if value, ok := values["VALUE"]; ok {
+		result.rows = append(result.rows, aggregatedMeasurement{
+			statistic:   statisticMean,
+			value:       value / float64(reported["VALUE"]),
+			aggregation: "mean per pod",
+		})
+	}
+
+	combined.AvailableTags = mergeAvailableTags(metrics)
+	return result
+}
+
+// mergeAvailableTags combines the tag values of all pods, e.g. URIs that only some pods have served
+func mergeAvailableTags(metrics []*actuator.MetricResponse) []actuator.AvailableTag {
+	var tags []string
+	values := make(map[string][]string)
+	for _, metric := range metrics {
+		for _, tag := range metric.AvailableTags {
+			if _, seen := values[tag.Tag]; !seen {
+				tags = append(tags, tag.Tag)
+			}
+			values[tag.Tag] = append(values[tag.Tag], tag.Values...)
+		}
+	}
+
+	merged := make([]actuator.AvailableTag, 0, len(tags))
+	for _, tag := range tags {
+		tagValues := slices.Clone(values[tag])
+		slices.Sort(tagValues)
+		merged = append(merged, actuator.AvailableTag{Tag: tag, Values: slices.Compact(tagValues)})
+	}
+	return merged
+}
+
+func displayAggregatedMetric(aggregated *aggregatedMetric, tags []string) {
+	metric := aggregated.metric
+
+	w := newTableWriter()
+	_, _ = fmt.Fprintf(w, "NAME\t%s\n", metric.Name)
+	_, _ = fmt.Fprintf(w, "DESCRIPTION\t%s\n", metric.Description)
+	_, _ = fmt.Fprintf(w, "BASE UNIT\t%s\n", metric.BaseUnit)
+	if len(tags) > 0 {
+		_, _ = fmt.Fprintf(w, "TAGS\t%s\n", strings.Join(tags, ", "))
+	}
+	_, _ = fmt.Fprintf(w, "PODS\t%d\n", aggregated.pods)
+	_ = w.Flush()
+	fmt.Println()
+
+	fmt.Println("MEASUREMENTS")
+	w = newTableWriter()
+	_, _ = fmt.Fprintln(w, "STATISTIC\tVALUE\tAGGREGATION")
+	for _, row := range aggregated.rows {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", row.statistic, formatMeasurement(row.statistic, row.value, metric.BaseUnit), row.aggregation)
+	}
+	_ = w.Flush()
+
+	displayAvailableTags(metric.AvailableTags)
+}
@@ -0,0 +1,255 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+const (
+	statisticCount     = "COUNT"
+	statisticTotalTime = "TOTAL_TIME"
+	// statisticMean is derived from COUNT and TOTAL_TIME and not reported by the actuator
+	statisticMean = "MEAN"
+
+	clearScreen = "\033[H\033[2J"
+)
+
+// runWatch polls the metrics of every pod until the context is cancelled and redraws the table after each poll
+func (o *metricsCommandOperations) runWatch(ctx context.Context) error {
+	clients := make(map[string]actuator.Client, len(o.pods))
+	var pods []string
+	for _, pod := range o.pods {
+		client, err := o.actuatorClientFactory.NewClient(ctx, pod)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %s: %v\n", pod, err)
+			continue
+		}
+		clients[pod] = client
+		pods = append(pods, pod)
+	}
+	if len(clients) == 0 {
+		return fmt.Errorf("watch failed on %d pod(s)", len(o.pods))
+	}
+
+	watch := newMetricsWatch(o.metricNames, o.tags, pods)
+	redraw := isTerminal(os.Stdout)
+
+	ticker := time.NewTicker(o.watch)
+	defer ticker.Stop()
+
+	for first := true; ; first = false {
+		for _, pod := range pods {
+			watch.poll(pod, clients[pod], time.Now())
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if redraw {
+			fmt.Print(clearScreen)
+		} else if !first {
+			fmt.Println()
+		}
+		fmt.Printf("Every %s: %s  %s\n\n", o.watch, strings.Join(o.metricNames, ", "), time.Now().Format(time.TimeOnly))
+		watch.render()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// metricsWatch keeps the last two samples of every pod to derive the change between polls
+type metricsWatch struct {
+	metricNames []string
+	tags        []string
+	pods        []string
+	previous    map[string]*metricsSample
+	current     map[string]*metricsSample
+}
+
+type metricsSample struct {
+	time    time.Time
+	metrics map[string]*actuator.MetricResponse
+	err     error
+}
+
+func newMetricsWatch(metricNames []string, tags []string, pods []string) *metricsWatch {
+	return &metricsWatch{
+		metricNames: metricNames,
+		tags:        tags,
+		pods:        pods,
+		previous:    make(map[string]*metricsSample),
+		current:     make(map[string]*metricsSample),
+	}
+}
+
+// poll fetches all metrics of a pod. A failed poll keeps the last successful sample as the baseline.
+func (w *metricsWatch) poll(pod string, client actuator.Client, now time.Time) {
+	sample := &metricsSample{time: now, metrics: make(map[string]*actuator.MetricResponse, len(w.metricNames))}
+	for _, name := range w.metricNames {
+		metric, err := client.GetMetric(name, w.tags...)
+		if err != nil {
+			sample.err = err
+			break
+		}
+		sample.metrics[name] = metric
+	}
+
+	if current := w.current[pod]; current != nil && current.err == nil {
+		w.previous[pod] = current
+	}
+	w.current[pod] = sample
+}
+
+// statistics returns the statistics of a metric reported by any pod, followed by the derived MEAN for timers.
+// Previous samples are included so that the rows remain while a pod fails to respond.
+func (w *metricsWatch) statistics(metricName string) []string {
+	var statistics []string
+	seen := make(map[string]bool)
+	for _, samples := range []map[string]*metricsSample{w.current, w.previous} {
+		for _, pod := range w.pods {
+			sample := samples[pod]
+			if sample == nil || sample.metrics[metricName] == nil {
+				continue
+			}
+			for _, m := range sample.metrics[metricName].Measurements {
+				if !seen[m.Statistic] {
+					seen[m.Statistic] = true
+					statistics = append(statistics, m.Statistic)
+				}
+			}
+		}
+	}
+
+	if seen[statisticCount] && seen[statisticTotalTime] {
+		statistics = append(statistics, statisticMean)
+	}
+	return statistics
+}
+
+func (w *metricsWatch) render() {
+	tw := newTableWriter()
+
+	_, _ = fmt.Fprintln(tw, "METRIC\tSTATISTIC\t"+strings.Join(w.pods, "\t"))
+	for _, name := range w.metricNames {
+		for _, statistic := range w.statistics(name) {
+			columns := []string{name, statistic}
+			for _, pod := range w.pods {
+				columns = append(columns, w.cell(pod, name, statistic))
+			}
+			_, _ = fmt.Fprintln(tw, strings.Join(columns, "\t"))
+		}
+	}
+	_ = tw.Flush()
+
+	for _, pod := range w.pods {
+		if sample := w.current[pod]; sample != nil && sample.err != nil {
+			fmt.Printf("Error: %s: %v\n", pod, sample.err)
+		}
+	}
+}
+
+func (w *metricsWatch) cell(pod string, metricName string, statistic string) string {
+	current := w.current[pod]
+	if current == nil {
+		return "-"
+	}
+	if current.err != nil {
+		return "error"
+	}
+
+	metric := current.metrics[metricName]
+	previous := w.previous[pod]
+	var previousMetric *actuator.MetricResponse
+	if previous != nil {
+		previousMetric = previous.metrics[metricName]
+	}
+
+	if statistic == statisticMean {
+		return formatWindowMean(previousMetric, metric)
+	}
+
+	value, ok := measurement(metric, statistic)
+	if !ok {
+		return "-"
+	}
+	formatted := formatMeasurement(statistic, value, metric.BaseUnit)
+
+	previousValue, ok := measurement(previousMetric, statistic)
+	if !ok {
+		return formatted
+	}
+	delta := value - previousValue
+
+	if !isCumulativeStatistic(statistic) {
+		return fmt.Sprintf("%s (%s)", formatted, formatMeasurementDelta(statistic, delta, metric.BaseUnit))
+	}
+	elapsed := current.time.Sub(previous.time).Seconds()
+	if delta < 0 || elapsed <= 0 {
+		// A negative delta means the counter was reset, e.g. because the application restarted
+		return formatted
+	}
+
+	rate := delta / elapsed
+	return fmt.Sprintf("%s (%s, %s)", formatted, formatMeasurementDelta(statistic, delta, metric.BaseUnit), formatRate(statistic, rate, metric.BaseUnit))
+}
+
+func isCumulativeStatistic(statistic string) bool {
+	return statistic == statisticCount || statistic == statisticTotalTime
+}
+
+func measurement(metric *actuator.MetricResponse, statistic string) (float64, bool) {
+	if metric == nil {
+		return 0, false
+	}
+	for _, m := range metric.Measurements {
+		if m.Statistic == statistic {
+			return m.Value, true
+		}
+	}
+	return 0, false
+}
+
+// formatWindowMean returns the mean latency of the requests between two samples of a timer
+func formatWindowMean(previous, current *actuator.MetricResponse) string {
+	count, okCount := measurement(current, statisticCount)
+	totalTime, okTotalTime := measurement(current, statisticTotalTime)
+	previousCount, okPreviousCount := measurement(previous, statisticCount)
+	previousTotalTime, okPreviousTotalTime := measurement(previous, statisticTotalTime)
+	if !okCount || !okTotalTime || !okPreviousCount || !okPreviousTotalTime {
+		return "-"
+	}
+
+	requests := count - previousCount
+	if requests <= 0 {
+		return "-"
+	}
+	return formatMetricValue((totalTime-previousTotalTime)/requests, current.BaseUnit)
+}
+
+func formatMeasurementDelta(statistic string, delta float64, unit string) string {
+	if delta == 0 {
+		return "+0"
+	}
+	sign := "+"
+	if delta < 0 {
+		sign = "-"
+	}
+	return sign + formatMeasurement(statistic, math.Abs(delta), unit)
+}
+
+func formatRate(statistic string, rate float64, unit string) string {
+	if statistic == statisticCount {
+		return fmt.Sprintf("%.2f/s", rate)
+	}
+	return formatMetricValue(rate, unit) + "/s"
+}
@@ -3,7 +3,10 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
 	"github.com/spf13/cobra"
@@ -14,6 +17,13 @@ type metricsCommandOperations struct {
 	baseOperations
 	filter     string
 	metricName string
+	// metricNames holds all metric name arguments; only --watch accepts more than one
+	metricNames []string
+	tags        []string
+	by          string
+	watch       time.Duration
+	aggregate   bool
+	output      string
 }
 
 func NewMetricsCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
@@ -25,13 +35,31 @@ func NewMetricsCommand(configFlags *genericclioptions.ConfigFlags, podResolver P
 	}
 
 	cmd := &cobra.Command{
-		Use:   "metrics [metric-name]",
+		Use:   "metrics [metric-name...]",
 		Short: "Get application metrics",
 		Long: `Get application metrics from Spring Boot Actuator.
 
 Without arguments, lists all available metrics.
-With a metric name argument, shows details for that specific metric.`,
-		Args: cobra.MaximumNArgs(1),
+With a metric name argument, shows details for that specific metric.
+
+Use --tag to restrict the measurements to meters with the given tags, e.g.
+--tag uri:/api/orders --tag status:500. With --by, the metric is broken
+down by the values of a tag: one request is made per value and the
+measurements are listed per value, e.g. request count and total time per
+URI with --by uri.
+
+With --watch, the given metrics are polled on all selected pods and shown
+in a table with a column per pod that is redrawn on every poll. Each value
+is followed by the change since the previous poll; COUNT and TOTAL_TIME
+also show the rate per second. For timers, the MEAN row is the mean
+latency of the requests within the last interval.
+
+With --aggregate, the metric of all selected pods is combined into one
+result: COUNT, TOTAL and TOTAL_TIME are summed up, MAX is the maximum of
+all pods and MEAN is the mean weighted by the COUNT of each pod. Gauges
+are summed up and their mean per pod is shown. Use -o wide to list the
+measurements of each pod as well.`,
+		Args: cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := operations.complete(cmd, args); err != nil {
 				return err
@@ -39,11 +67,22 @@ With a metric name argument, shows details for that specific metric.`,
 			if err := operations.validate(); err != nil {
 				return err
 			}
+			if operations.watch > 0 {
+				return operations.runWatch(cmd.Context())
+			}
+			if operations.aggregate {
+				return operations.runAggregate(cmd.Context())
+			}
 			return RunForEachPod(cmd.Context(), operations.pods, "get metrics", operations.runForPod)
 		},
 	}
 
 	cmd.Flags().StringVarP(&operations.filter, "filter", "f", "", "Filter metrics by name pattern")
+	cmd.Flags().StringArrayVar(&operations.tags, "tag", nil, "Only include meters with this tag as KEY:VALUE (can be repeated)")
+	cmd.Flags().StringVar(&operations.by, "by", "", "Break the metric down by the values of this tag")
+	cmd.Flags().DurationVar(&operations.watch, "watch", 0, "Poll the metrics at this interval and show changes (e.g., 5s)")
+	cmd.Flags().BoolVar(&operations.aggregate, "aggregate", false, "Combine the metric of all selected pods into one result")
+	cmd.Flags().StringVarP(&operations.output, "output", "o", "", "Output format for --aggregate. One of: wide")
 
 	return cmd
 }
@@ -56,12 +95,61 @@ func (o *metricsCommandOperations) complete(cmd *cobra.Command, args []string) e
 	if len(args) >= 1 {
 		o.metricName = args[0]
 	}
+	o.metricNames = args
 
 	return nil
 }
 
 func (o *metricsCommandOperations) validate() error {
-	return o.validatePods()
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+
+	if len(o.metricNames) == 0 && (len(o.tags) > 0 || o.by != "") {
+		return fmt.Errorf("--tag and --by require a metric name")
+	}
+
+	if o.watch < 0 {
+		return fmt.Errorf("--watch must be positive")
+	}
+	if o.watch > 0 {
+		if len(o.metricNames) == 0 {
+			return fmt.Errorf("--watch requires at least one metric name")
+		}
+		if o.by != "" {
+			return fmt.Errorf("--watch cannot be used with --by")
+		}
+	} else if len(o.metricNames) > 1 {
+		return fmt.Errorf("multiple metric names require --watch")
+	}
+
+	if o.aggregate {
+		if len(o.metricNames) == 0 {
+			return fmt.Errorf("--aggregate requires a metric name")
+		}
+		if o.by != "" || o.watch > 0 {
+			return fmt.Errorf("--aggregate cannot be used with --by or --watch")
+		}
+	}
+
+	if err := validateOutputFormat(o.output, OutputFormatWide); err != nil {
+		return err
+	}
+	if o.output != "" && !o.aggregate {
+		return fmt.Errorf("-o %s requires --aggregate", o.output)
+	}
+
+	for _, tag := range o.tags {
+		key, _, found := strings.Cut(tag, ":")
+		if !found || key == "" {
+			return fmt.Errorf("invalid tag '%s': expected KEY:VALUE", tag)
+		}
+		if key == o.by {
+			return fmt.Errorf("--by %s cannot be combined with --tag %s", o.by, tag)
+		}
+	}
+
+	return nil
 }
 
 func (o *metricsCommandOperations) runForPod(ctx context.Context, podName string) error {
@@ -70,6 +158,9 @@ func (o *metricsCommandOperations) runForPod(ctx context.Context, podName string
 		return err
 	}
 
+	if o.by != "" {
+		return o.displayBreakdown(client)
+	}
 	if o.metricName != "" {
 		return o.displayMetric(client)
 	}
@@ -92,19 +183,118 @@ func (o *metricsCommandOperations) listMetrics(client actuator.Client) error {
 }
 
 func (o *metricsCommandOperations) displayMetric(client actuator.Client) error {
-	metric, err := client.GetMetric(o.metricName)
+	metric, err := client.GetMetric(o.metricName, o.tags...)
 	if err != nil {
 		return err
 	}
 
-	return displayMetricFormatted(metric)
+	return displayMetricFormatted(metric, o.tags)
 }
 
-func displayMetricFormatted(metric *actuator.MetricResponse) error {
+// displayBreakdown requests the metric once per value of the --by tag and lists the measurements per value
+func (o *metricsCommandOperations) displayBreakdown(client actuator.Client) error {
+	metric, err := client.GetMetric(o.metricName, o.tags...)
+	if err != nil {
+		return err
+	}
+
+	values, err := availableTagValues(metric, o.by)
+	if err != nil {
+		return err
+	}
+
+	rows := make([]metricBreakdownRow, 0, len(values))
+	for _, value := range values {
+		tags := append(slices.Clone(o.tags), o.by+":"+value)
+		tagged, err := client.GetMetric(o.metricName, tags...)
+		if err != nil {
+			return fmt.Errorf("%s:%s: %w", o.by, value, err)
+		}
+		rows = append(rows, metricBreakdownRow{name: value, measurements: tagged.Measurements})
+	}
+
+	displayMetricBreakdown(strings.ToUpper(o.by), metric, rows)
+	return nil
+}
+
+func availableTagValues(metric *actuator.MetricResponse, tag string) ([]string, error) {
+	available := make([]string, 0, len(metric.AvailableTags))
+	for _, t := range metric.AvailableTags {
+		if t.Tag == tag {
+			return t.Values, nil
+		}
+		available = append(available, t.Tag)
+	}
+
+	if len(available) == 0 {
+		return nil, fmt.Errorf("tag '%s' is not available for metric %s", tag, metric.Name)
+	}
+	return nil, fmt.Errorf("tag '%s' is not available for metric %s (available: %s)", tag, metric.Name, strings.Join(available, ", "))
+}
+
+type metricBreakdownRow struct {
+	name         string
+	measurements []actuator.Measurement
+}
+
+// displayMetricBreakdown prints one row per tag value or pod, ordered by the first statistic (e.g. COUNT) descending
+func displayMetricBreakdown(header string, metric *actuator.MetricResponse, rows []metricBreakdownRow) {
+	if len(rows) == 0 {
+		fmt.Println("No tag values found")
+		return
+	}
+
+	statistics := make([]string, 0, len(metric.Measurements))
+	for _, m := range metric.Measurements {
+		statistics = append(statistics, m.Statistic)
+	}
+
+	measurementValue := func(row metricBreakdownRow, statistic string) (float64, bool) {
+		for _, m := range row.measurements {
+			if m.Statistic == statistic {
+				return m.Value, true
+			}
+		}
+		return 0, false
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if len(statistics) > 0 {
+			a, _ := measurementValue(rows[i], statistics[0])
+			b, _ := measurementValue(rows[j], statistics[0])
+			if a != b {
+				return a > b
+			}
+		}
+		return rows[i].name < rows[j].name
+	})
+
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	_, _ = fmt.Fprintln(w, header+"\t"+strings.Join(statistics, "\t"))
+	for _, row := range rows {
+		columns := []string{row.name}
+		for _, statistic := range statistics {
+			value, ok := measurementValue(row, statistic)
+			if !ok {
+				columns = append(columns, "-")
+				continue
+			}
+			columns = append(columns, formatMeasurement(statistic, value, metric.BaseUnit))
+		}
+		_, _ = fmt.Fprintln(w, strings.Join(columns, "\t"))
+	}
+}
+
+func displayMetricFormatted(metric *actuator.MetricResponse, tags []string) error {
 	w := newTableWriter()
 	_, _ = fmt.Fprintf(w, "NAME\t%s\n", metric.Name)
 	_, _ = fmt.Fprintf(w, "DESCRIPTION\t%s\n", metric.Description)
 	_, _ = fmt.Fprintf(w, "BASE UNIT\t%s\n", metric.BaseUnit)
+	if len(tags) > 0 {
+		_, _ = fmt.Fprintf(w, "TAGS\t%s\n", strings.Join(tags, ", "))
+	}
 	_ = w.Flush()
 	fmt.Println()
 
@@ -112,24 +302,41 @@ func displayMetricFormatted(metric *actuator.MetricResponse) error {
 	w = newTableWriter()
 	_, _ = fmt.Fprintln(w, "STATISTIC\tVALUE")
 	for _, m := range metric.Measurements {
-		_, _ = fmt.Fprintf(w, "%s\t%s\n", m.Statistic, formatMetricValue(m.Value, metric.BaseUnit))
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", m.Statistic, formatMeasurement(m.Statistic, m.Value, metric.BaseUnit))
 	}
 	_ = w.Flush()
 
-	if len(metric.AvailableTags) > 0 {
-		fmt.Println()
-		fmt.Println("AVAILABLE TAGS")
-		tagWriter := newTableWriter()
-		_, _ = fmt.Fprintln(tagWriter, "TAG\tVALUES")
-		for _, tag := range metric.AvailableTags {
-			_, _ = fmt.Fprintf(tagWriter, "%s\t%s\n", tag.Tag, strings.Join(tag.Values, ", "))
-		}
-		_ = tagWriter.Flush()
-	}
+	displayAvailableTags(metric.AvailableTags)
 
 	return nil
 }
 
+func displayAvailableTags(tags []actuator.AvailableTag) {
+	if len(tags) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println("AVAILABLE TAGS")
+	tagWriter := newTableWriter()
+	_, _ = fmt.Fprintln(tagWriter, "TAG\tVALUES")
+	for _, tag := range tags {
+		_, _ = fmt.Fprintf(tagWriter, "%s\t%s\n", tag.Tag, strings.Join(tag.Values, ", "))
+	}
+	_ = tagWriter.Flush()
+}
+
+// formatMeasurement formats a measurement in the base unit of the metric, except for statistics that
+// are counts regardless of the unit, like the COUNT of a timer
+func formatMeasurement(statistic string, value float64, unit string) string {
+	switch statistic {
+	case "COUNT", "ACTIVE_TASKS":
+		return fmt.Sprintf("%.0f", value)
+	default:
+		return formatMetricValue(value, unit)
+	}
+}
+
 func formatMetricValue(value float64, unit string) string {
 	switch unit {
 	case "bytes":
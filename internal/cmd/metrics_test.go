@@ -0,0 +1,534 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+// fakeMetricsClient serves metrics keyed by the metric name followed by the requested tags,
+// e.g. "http.server.requests uri:/api/orders"
+type fakeMetricsClient struct {
+	actuator.Client
+	metrics  map[string]*actuator.MetricResponse
+	requests []string
+}
+
+func (f *fakeMetricsClient) GetMetric(metricName string, tags ...string) (*actuator.MetricResponse, error) {
+	key := strings.Join(append([]string{metricName}, tags...), " ")
+	f.requests = append(f.requests, key)
+	if metric, ok := f.metrics[key]; ok {
+		return metric, nil
+	}
+	return nil, fmt.Errorf("metric '%s' not found: 404", key)
+}
+
+func testRequestsMetric(count, totalTime, maxTime float64, tags ...actuator.AvailableTag) *actuator.MetricResponse {
+	return &actuator.MetricResponse{
+		Name:     "http.server.requests",
+		BaseUnit: "seconds",
+		Measurements: []actuator.Measurement{
+			{Statistic: "COUNT", Value: count},
+			{Statistic: "TOTAL_TIME", Value: totalTime},
+			{Statistic: "MAX", Value: maxTime},
+		},
+		AvailableTags: tags,
+	}
+}
+
+func TestMetricsValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		pods        []string
+		metricNames []string
+		tags        []string
+		by          string
+		watch       time.Duration
+		aggregate   bool
+		output      string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "list metrics",
+			pods:    []string{"pod-1"},
+			wantErr: false,
+		},
+		{
+			name:        "tags and by",
+			pods:        []string{"pod-1"},
+			metricNames: []string{"http.server.requests"},
+			tags:        []string{"uri:/api/orders", "status:500"},
+			by:          "method",
+			wantErr:     false,
+		},
+		{
+			name:        "tag value with colon",
+			pods:        []string{"pod-1"},
+			metricNames: []string{"http.server.requests"},
+			tags:        []string{"uri:/api/orders:batch"},
+			wantErr:     false,
+		},
+		{
+			name:        "tag without metric name",
+			pods:        []string{"pod-1"},
+			tags:        []string{"uri:/api/orders"},
+			wantErr:     true,
+			errContains: "--tag and --by require a metric name",
+		},
+		{
+			name:        "by without metric name",
+			pods:        []string{"pod-1"},
+			by:          "uri",
+			wantErr:     true,
+			errContains: "--tag and --by require a metric name",
+		},
+		{
+			name:        "tag without value separator",
+			pods:        []string{"pod-1"},
+			metricNames: []string{"http.server.requests"},
+			tags:        []string{"uri"},
+			wantErr:     true,
+			errContains: "invalid tag 'uri': expected KEY:VALUE",
+		},
+		{
+			name:        "tag without key",
+			pods:        []string{"pod-1"},
+			metricNames: []string{"http.server.requests"},
+			tags:        []string{":500"},
+			wantErr:     true,
+			errContains: "expected KEY:VALUE",
+		},
+		{
+			name:        "by tag also filtered",
+			pods:        []string{"pod-1"},
+			metricNames: []string{"http.server.requests"},
+			tags:        []string{"uri:/api/orders"},
+			by:          "uri",
+			wantErr:     true,
+			errContains: "--by uri cannot be combined with --tag uri:/api/orders",
+		},
+		{
+			name:        "watch several metrics",
+			pods:        []string{"pod-1", "pod-2"},
+			metricNames: []string{"http.server.requests", "jvm.memory.used"},
+			tags:        []string{"uri:/api/orders"},
+			watch:       5 * time.Second,
+			wantErr:     false,
+		},
+		{
+			name:        "several metrics without watch",
+			pods:        []string{"pod-1"},
+			metricNames: []string{"http.server.requests", "jvm.memory.used"},
+			wantErr:     true,
+			errContains: "multiple metric names require --watch",
+		},
+		{
+			name:        "watch without metric name",
+			pods:        []string{"pod-1"},
+			watch:       5 * time.Second,
+			wantErr:     true,
+			errContains: "--watch requires at least one metric name",
+		},
+		{
+			name:        "watch with by",
+			pods:        []string{"pod-1"},
+			metricNames: []string{"http.server.requests"},
+			by:          "uri",
+			watch:       5 * time.Second,
+			wantErr:     true,
+			errContains: "--watch cannot be used with --by",
+		},
+		{
+			name:        "negative watch interval",
+			pods:        []string{"pod-1"},
+			metricNames: []string{"http.server.requests"},
+			watch:       -time.Second,
+			wantErr:     true,
+			errContains: "--watch must be positive",
+		},
+		{
+			name:        "aggregate wide",
+			pods:        []string{"pod-1", "pod-2"},
+			metricNames: []string{"http.server.requests"},
+			tags:        []string{"uri:/api/orders"},
+			aggregate:   true,
+			output:      "wide",
+			wantErr:     false,
+		},
+		{
+			name:        "aggregate without metric name",
+			pods:        []string{"pod-1"},
+			aggregate:   true,
+			wantErr:     true,
+			errContains: "--aggregate requires a metric name",
+		},
+		{
+			name:        "aggregate with by",
+			pods:        []string{"pod-1"},
+			metricNames: []string{"http.server.requests"},
+			by:          "uri",
+			aggregate:   true,
+			wantErr:     true,
+			errContains: "--aggregate cannot be used with --by or --watch",
+		},
+		{
+			name:        "aggregate with watch",
+			pods:        []string{"pod-1"},
+			metricNames: []string{"http.server.requests"},
+			watch:       5 * time.Second,
+			aggregate:   true,
+			wantErr:     true,
+			errContains: "--aggregate cannot be used with --by or --watch",
+		},
+		{
+			name:        "invalid output format",
+			pods:        []string{"pod-1"},
+			metricNames: []string{"http.server.requests"},
+			aggregate:   true,
+			output:      "json",
+			wantErr:     true,
+			errContains: "output format \"json\" not recognized",
+		},
+		{
+			name:        "wide output without aggregate",
+			pods:        []string{"pod-1"},
+			metricNames: []string{"http.server.requests"},
+			output:      "wide",
+			wantErr:     true,
+			errContains: "-o wide requires --aggregate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &metricsCommandOperations{
+				baseOperations: baseOperations{pods: tt.pods},
+				metricNames:    tt.metricNames,
+				tags:           tt.tags,
+				by:             tt.by,
+				watch:          tt.watch,
+				aggregate:      tt.aggregate,
+				output:         tt.output,
+			}
+
+			err := ops.validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("validate() error = %v, want error containing %q", err, tt.errContains)
+			}
+		})
+	}
+}
+
+func TestDisplayBreakdown(t *testing.T) {
+	client := &fakeMetricsClient{
+		metrics: map[string]*actuator.MetricResponse{
+			"http.server.requests status:200": testRequestsMetric(130, 6.5, 0.9,
+				actuator.AvailableTag{Tag: "uri", Values: []string{"/api/users", "/api/orders"}},
+				actuator.AvailableTag{Tag: "method", Values: []string{"GET"}},
+			),
+			"http.server.requests status:200 uri:/api/users":  testRequestsMetric(10, 0.5, 0.2),
+			"http.server.requests status:200 uri:/api/orders": testRequestsMetric(120, 6, 0.9),
+		},
+	}
+	ops := &metricsCommandOperations{metricName: "http.server.requests", tags: []string{"status:200"}, by: "uri"}
+
+	var err error
+	output := captureOutput(func() {
+		err = ops.displayBreakdown(client)
+	})
+	if err != nil {
+		t.Fatalf("displayBreakdown() error = %v", err)
+	}
+
+	expectedRegex := []string{
+		`URI\s+COUNT\s+TOTAL_TIME\s+MAX`,
+		`/api/orders\s+120\s+6\.00 s\s+900\.00 ms`,
+		`/api/users\s+10\s+500\.00 ms\s+200\.00 ms`,
+		`(?s)/api/orders.*/api/users`,
+	}
+	for _, pattern := range expectedRegex {
+		if !regexp.MustCompile(pattern).MatchString(output) {
+			t.Errorf("expected output to match %q, got:\n%s", pattern, output)
+		}
+	}
+
+	if len(client.requests) != 3 {
+		t.Errorf("expected 3 requests, got %v", client.requests)
+	}
+}
+
+func TestDisplayBreakdownErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		metrics     map[string]*actuator.MetricResponse
+		by          string
+		errContains string
+	}{
+		{
+			name: "unknown tag",
+			metrics: map[string]*actuator.MetricResponse{
+				"http.server.requests": testRequestsMetric(1, 1, 1, actuator.AvailableTag{Tag: "uri", Values: []string{"/"}}),
+			},
+			by:          "outcome",
+			errContains: "tag 'outcome' is not available for metric http.server.requests (available: uri)",
+		},
+		{
+			name: "no tags",
+			metrics: map[string]*actuator.MetricResponse{
+				"http.server.requests": testRequestsMetric(1, 1, 1),
+			},
+			by:          "uri",
+			errContains: "tag 'uri' is not available for metric http.server.requests",
+		},
+		{
+			name: "tag value request fails",
+			metrics: map[string]*actuator.MetricResponse{
+				"http.server.requests": testRequestsMetric(1, 1, 1, actuator.AvailableTag{Tag: "uri", Values: []string{"/gone"}}),
+			},
+			by:          "uri",
+			errContains: "uri:/gone: metric",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeMetricsClient{metrics: tt.metrics}
+			ops := &metricsCommandOperations{metricName: "http.server.requests", by: tt.by}
+
+			err := ops.displayBreakdown(client)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+			}
+		})
+	}
+}
+
+func TestDisplayMetricWithTags(t *testing.T) {
+	client := &fakeMetricsClient{
+		metrics: map[string]*actuator.MetricResponse{
+			"http.server.requests uri:/api/orders status:500": testRequestsMetric(2, 1.5, 1),
+		},
+	}
+	ops := &metricsCommandOperations{metricName: "http.server.requests", tags: []string{"uri:/api/orders", "status:500"}}
+
+	var err error
+	output := captureOutput(func() {
+		err = ops.displayMetric(client)
+	})
+	if err != nil {
+		t.Fatalf("displayMetric() error = %v", err)
+	}
+
+	expectedRegex := []string{
+		`TAGS\s+uri:/api/orders, status:500`,
+		`COUNT\s+2\n`,
+		`TOTAL_TIME\s+1\.50 s`,
+	}
+	for _, pattern := range expectedRegex {
+		if !regexp.MustCompile(pattern).MatchString(output) {
+			t.Errorf("expected output to match %q, got:\n%s", pattern, output)
+		}
+	}
+}
+
+func TestFormatMeasurement(t *testing.T) {
+	tests := []struct {
+		statistic string
+		value     float64
+		unit      string
+		want      string
+	}{
+		{statistic: "COUNT", value: 1200, unit: "seconds", want: "1200"},
+		{statistic: "ACTIVE_TASKS", value: 3, unit: "seconds", want: "3"},
+		{statistic: "TOTAL_TIME", value: 1.5, unit: "seconds", want: "1.50 s"},
+		{statistic: "VALUE", value: 2048, unit: "bytes", want: "2.0 KB"},
+		{statistic: "VALUE", value: 0.5, unit: "", want: "0.50"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.statistic+" "+tt.want, func(t *testing.T) {
+			if got := formatMeasurement(tt.statistic, tt.value, tt.unit); got != tt.want {
+				t.Errorf("formatMeasurement() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetricsWatch(t *testing.T) {
+	start := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	memory := func(used float64) *actuator.MetricResponse {
+		return &actuator.MetricResponse{
+			Name:         "jvm.memory.used",
+			BaseUnit:     "bytes",
+			Measurements: []actuator.Measurement{{Statistic: "VALUE", Value: used}},
+		}
+	}
+
+	first := &fakeMetricsClient{metrics: map[string]*actuator.MetricResponse{
+		"http.server.requests": testRequestsMetric(100, 5, 0.5),
+		"jvm.memory.used":      memory(1024 * 1024),
+	}}
+	second := &fakeMetricsClient{metrics: map[string]*actuator.MetricResponse{
+		"http.server.requests": testRequestsMetric(50, 2, 0.1),
+		"jvm.memory.used":      memory(2 * 1024 * 1024),
+	}}
+
+	watch := newMetricsWatch([]string{"http.server.requests", "jvm.memory.used"}, nil, []string{"pod-1", "pod-2"})
+	watch.poll("pod-1", first, start)
+	watch.poll("pod-2", second, start)
+
+	output := captureOutput(watch.render)
+	expectedRegex := []string{
+		`METRIC\s+STATISTIC\s+pod-1\s+pod-2`,
+		`http\.server\.requests\s+COUNT\s+100\s+50\n`,
+		`http\.server\.requests\s+MEAN\s+-\s+-\n`,
+		`jvm\.memory\.used\s+VALUE\s+1\.0 MB\s+2\.0 MB\n`,
+	}
+	for _, pattern := range expectedRegex {
+		if !regexp.MustCompile(pattern).MatchString(output) {
+			t.Errorf("first poll: expected output to match %q, got:\n%s", pattern, output)
+		}
+	}
+
+	// 20 requests taking 1s in total within 10s on pod-1; pod-2 restarted and its counters were reset
+	first.metrics["http.server.requests"] = testRequestsMetric(120, 6, 0.5)
+	first.metrics["jvm.memory.used"] = memory(512 * 1024)
+	second.metrics["http.server.requests"] = testRequestsMetric(3, 0.3, 0.1)
+	watch.poll("pod-1", first, start.Add(10*time.Second))
+	watch.poll("pod-2", second, start.Add(10*time.Second))
+
+	output = captureOutput(watch.render)
+	expectedRegex = []string{
+		`http\.server\.requests\s+COUNT\s+120 \(\+20, 2\.00/s\)\s+3\n`,
+		`http\.server\.requests\s+TOTAL_TIME\s+6\.00 s \(\+1\.00 s, 100\.00 ms/s\)\s+300\.00 ms\n`,
+		`http\.server\.requests\s+MAX\s+500\.00 ms \(\+0\)`,
+		`http\.server\.requests\s+MEAN\s+50\.00 ms\s+-\n`,
+		`jvm\.memory\.used\s+VALUE\s+512\.0 KB \(-512\.0 KB\)`,
+	}
+	for _, pattern := range expectedRegex {
+		if !regexp.MustCompile(pattern).MatchString(output) {
+			t.Errorf("second poll: expected output to match %q, got:\n%s", pattern, output)
+		}
+	}
+}
+
+func TestMetricsWatchPollError(t *testing.T) {
+	start := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	client := &fakeMetricsClient{metrics: map[string]*actuator.MetricResponse{
+		"http.server.requests": testRequestsMetric(100, 5, 0.5),
+	}}
+
+	watch := newMetricsWatch([]string{"http.server.requests"}, nil, []string{"pod-1"})
+	watch.poll("pod-1", client, start)
+
+	delete(client.metrics, "http.server.requests")
+	watch.poll("pod-1", client, start.Add(5*time.Second))
+
+	output := captureOutput(watch.render)
+	if !strings.Contains(output, "error") || !strings.Contains(output, "Error: pod-1: metric 'http.server.requests' not found") {
+		t.Errorf("expected the error to be shown, got:\n%s", output)
+	}
+
+	// The last successful sample remains the baseline
+	client.metrics["http.server.requests"] = testRequestsMetric(110, 5.5, 0.5)
+	watch.poll("pod-1", client, start.Add(10*time.Second))
+
+	output = captureOutput(watch.render)
+	if !regexp.MustCompile(`COUNT\s+110 \(\+10, 1\.00/s\)`).MatchString(output) {
+		t.Errorf("expected the rate over 10s, got:\n%s", output)
+	}
+}
+
+func TestAggregateMetrics(t *testing.T) {
+	metrics := []*actuator.MetricResponse{
+		testRequestsMetric(100, 5, 0.5, actuator.AvailableTag{Tag: "uri", Values: []string{"/api/users", "/api/orders"}}),
+		testRequestsMetric(300, 3, 0.9, actuator.AvailableTag{Tag: "uri", Values: []string{"/api/orders", "/actuator/health"}}),
+	}
+
+	aggregated := aggregateMetrics(metrics)
+
+	want := []aggregatedMeasurement{
+		{statistic: "COUNT", value: 400, aggregation: "sum"},
+		{statistic: "TOTAL_TIME", value: 8, aggregation: "sum"},
+		{statistic: "MAX", value: 0.9, aggregation: "max"},
+		{statistic: "MEAN", value: 0.02, aggregation: "TOTAL_TIME / COUNT"},
+	}
+	if fmt.Sprint(aggregated.rows) != fmt.Sprint(want) {
+		t.Errorf("rows = %v, want %v", aggregated.rows, want)
+	}
+	if aggregated.pods != 2 {
+		t.Errorf("pods = %d, want 2", aggregated.pods)
+	}
+	if got := aggregated.metric.AvailableTags[0].Values; strings.Join(got, ",") != "/actuator/health,/api/orders,/api/users" {
+		t.Errorf("merged tag values = %v", got)
+	}
+}
+
+func TestAggregateGauges(t *testing.T) {
+	metrics := []*actuator.MetricResponse{
+		testValueMetric("jvm.threads.live", 30),
+		testValueMetric("jvm.threads.live", 50),
+	}
+
+	aggregated := aggregateMetrics(metrics)
+
+	want := []aggregatedMeasurement{
+		{statistic: "VALUE", value: 80, aggregation: "sum"},
+		{statistic: "MEAN", value: 40, aggregation: "mean per pod"},
+	}
+	if fmt.Sprint(aggregated.rows) != fmt.Sprint(want) {
+		t.Errorf("rows = %v, want %v", aggregated.rows, want)
+	}
+}
+
+func TestAggregateMetricsWithoutEvents(t *testing.T) {
+	aggregated := aggregateMetrics([]*actuator.MetricResponse{testRequestsMetric(0, 0, 0), testRequestsMetric(0, 0, 0)})
+
+	for _, row := range aggregated.rows {
+		if row.statistic == statisticMean {
+			t.Errorf("expected no MEAN without events, got %v", row)
+		}
+	}
+}
+
+func TestDisplayAggregatedMetric(t *testing.T) {
+	metrics := []*actuator.MetricResponse{testRequestsMetric(100, 5, 0.5), testRequestsMetric(300, 3, 0.9)}
+	aggregated := aggregateMetrics(metrics)
+
+	output := captureOutput(func() {
+		displayAggregatedMetric(aggregated, []string{"uri:/api/orders"})
+		displayMetricBreakdown("POD", aggregated.metric, []metricBreakdownRow{
+			{name: "pod-a", measurements: metrics[0].Measurements},
+			{name: "pod-b", measurements: metrics[1].Measurements},
+		})
+	})
+
+	expectedRegex := []string{
+		`TAGS\s+uri:/api/orders`,
+		`PODS\s+2\n`,
+		`STATISTIC\s+VALUE\s+AGGREGATION`,
+		`COUNT\s+400\s+sum`,
+		`TOTAL_TIME\s+8\.00 s\s+sum`,
+		`MAX\s+900\.00 ms\s+max`,
+		`MEAN\s+20\.00 ms\s+TOTAL_TIME / COUNT`,
+		`POD\s+COUNT\s+TOTAL_TIME\s+MAX`,
+		`(?s)pod-b\s+300.*pod-a\s+100`,
+	}
+	for _, pattern := range expectedRegex {
+		if !regexp.MustCompile(pattern).MatchString(output) {
+			t.Errorf("expected output to match %q, got:\n%s", pattern, output)
+		}
+	}
+}
@@ -0,0 +1,361 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const (
+	migrationTimestampFormat = "2006-01-02 15:04:05"
+	maxMigrationDescLength   = 50
+	flywayEndpoint           = "flyway"
+	liquibaseEndpoint        = "liquibase"
+	flywayStatePending       = "PENDING"
+	flywayStateOutdated      = "OUTDATED"
+	liquibaseExecTypeFailed  = "FAILED"
+	liquibaseExecTypeSkipped = "SKIPPED"
+)
+
+type migrationsCommandOperations struct {
+	baseOperations
+	output string
+	// latest holds the latest applied migration per pod and datasource, used to detect drift between replicas
+	latest map[string]map[string]string
+}
+
+func NewMigrationsCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &migrationsCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "migrations",
+		Short: "Show database migration status",
+		Long: `Show database migrations from the Flyway or Liquibase endpoint of Spring Boot Actuator.
+
+The endpoint is chosen based on the endpoints the application exposes.
+Migrations are listed per datasource, i.e. per Flyway or Liquibase bean.
+
+Flyway reports pending and failed migrations. Liquibase only reports
+change sets that have already been run.
+
+When several pods are selected, a warning is shown if they report
+different latest applied migrations.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			err := RunForEachPod(cmd.Context(), operations.pods, "get migrations", operations.runForPod)
+			operations.displayVersionDrift()
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&operations.output, "output", "o", "", "Output format. One of: wide")
+
+	return cmd
+}
+
+func (o *migrationsCommandOperations) validate() error {
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+	return validateOutputFormat(o.output, OutputFormatWide)
+}
+
+func (o *migrationsCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	endpoints, err := client.GetAvailableEndpoints()
+	if err != nil {
+		return err
+	}
+
+	hasFlyway := slices.Contains(endpoints, flywayEndpoint)
+	hasLiquibase := slices.Contains(endpoints, liquibaseEndpoint)
+	if !hasFlyway && !hasLiquibase {
+		return fmt.Errorf("neither the 'flyway' nor the 'liquibase' endpoint is available\nMake sure Flyway or Liquibase is used and its endpoint is exposed in your Spring Boot configuration: https://docs.spring.io/spring-boot/reference/actuator/endpoints.html")
+	}
+
+	latest := make(map[string]string)
+	wideMode := o.output == OutputFormatWide
+
+	if hasFlyway {
+		flyway, err := client.GetFlyway()
+		if err != nil {
+			return err
+		}
+		displayFlyway(flyway, wideMode, latest)
+	}
+
+	if hasLiquibase {
+		if hasFlyway {
+			fmt.Println()
+		}
+		liquibase, err := client.GetLiquibase()
+		if err != nil {
+			return err
+		}
+		displayLiquibase(liquibase, wideMode, latest)
+	}
+
+	if o.latest == nil {
+		o.latest = make(map[string]map[string]string)
+	}
+	o.latest[podName] = latest
+	return nil
+}
+
+// datasourceName identifies a Flyway or Liquibase bean. The context is only included if there are several.
+func datasourceName(backend string, contextName string, beanName string, contextCount int) string {
+	if contextCount > 1 {
+		return fmt.Sprintf("%s %s/%s", backend, contextName, beanName)
+	}
+	return fmt.Sprintf("%s %s", backend, beanName)
+}
+
+func displayFlyway(flyway *actuator.FlywayResponse, wideMode bool, latest map[string]string) {
+	first := true
+	for _, contextName := range slices.Sorted(maps.Keys(flyway.Contexts)) {
+		beans := flyway.Contexts[contextName].FlywayBeans
+		for _, beanName := range slices.Sorted(maps.Keys(beans)) {
+			if !first {
+				fmt.Println()
+			}
+			first = false
+
+			name := datasourceName("Flyway", contextName, beanName, len(flyway.Contexts))
+			migrations := beans[beanName].Migrations
+
+			fmt.Printf("%s:\n", name)
+			if len(migrations) == 0 {
+				fmt.Println("No migrations found")
+				latest[name] = "-"
+				continue
+			}
+
+			displayFlywayMigrationsTable(migrations, wideMode)
+
+			summary := summarizeFlywayMigrations(migrations)
+			latest[name] = summary.latest
+			fmt.Printf("\nLatest applied version: %s (%d pending, %d failed)\n", summary.latest, summary.pending, summary.failed)
+		}
+	}
+}
+
+type migrationSummary struct {
+	latest  string
+	pending int
+	failed  int
+}
+
+func isFlywayMigrationApplied(migration actuator.FlywayMigration) bool {
+	return migration.InstalledRank != nil && !strings.Contains(migration.State, "FAILED")
+}
+
+func summarizeFlywayMigrations(migrations []actuator.FlywayMigration) migrationSummary {
+	summary := migrationSummary{latest: "-"}
+	latestRank := -1
+	for _, migration := range migrations {
+		switch {
+		case strings.Contains(migration.State, "FAILED"):
+			summary.failed++
+		case migration.State == flywayStatePending || migration.State == flywayStateOutdated:
+			summary.pending++
+		}
+
+		// Repeatable migrations have no version and are not taken into account
+		if isFlywayMigrationApplied(migration) && migration.Version != "" && *migration.InstalledRank > latestRank {
+			latestRank = *migration.InstalledRank
+			summary.latest = migration.Version
+		}
+	}
+	return summary
+}
+
+func displayFlywayMigrationsTable(migrations []actuator.FlywayMigration, wideMode bool) {
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	if wideMode {
+		_, _ = fmt.Fprintln(w, "RANK\tVERSION\tDESCRIPTION\tTYPE\tSTATE\tCHECKSUM\tINSTALLED ON\tINSTALLED BY\tEXECUTION TIME\tSCRIPT")
+	} else {
+		_, _ = fmt.Fprintln(w, "VERSION\tDESCRIPTION\tTYPE\tSTATE\tCHECKSUM\tINSTALLED ON")
+	}
+
+	for _, migration := range migrations {
+		checksum := "-"
+		if migration.Checksum != nil {
+			checksum = strconv.FormatInt(*migration.Checksum, 10)
+		}
+
+		if wideMode {
+			rank := "-"
+			if migration.InstalledRank != nil {
+				rank = strconv.Itoa(*migration.InstalledRank)
+			}
+			executionTime := "-"
+			if migration.ExecutionTime != nil {
+				executionTime = formatDurationPrecise(time.Duration(*migration.ExecutionTime) * time.Millisecond)
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				rank, valueOrDash(migration.Version), migration.Description, migration.Type, migration.State,
+				checksum, formatMigrationTimestamp(migration.InstalledOn), valueOrDash(migration.InstalledBy),
+				executionTime, migration.Script)
+		} else {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				valueOrDash(migration.Version), truncateString(migration.Description, maxMigrationDescLength),
+				migration.Type, migration.State, checksum, formatMigrationTimestamp(migration.InstalledOn))
+		}
+	}
+}
+
+func displayLiquibase(liquibase *actuator.LiquibaseResponse, wideMode bool, latest map[string]string) {
+	first := true
+	for _, contextName := range slices.Sorted(maps.Keys(liquibase.Contexts)) {
+		beans := liquibase.Contexts[contextName].LiquibaseBeans
+		for _, beanName := range slices.Sorted(maps.Keys(beans)) {
+			if !first {
+				fmt.Println()
+			}
+			first = false
+
+			name := datasourceName("Liquibase", contextName, beanName, len(liquibase.Contexts))
+			changeSets := slices.Clone(beans[beanName].ChangeSets)
+			sort.SliceStable(changeSets, func(i, j int) bool {
+				return changeSets[i].OrderExecuted < changeSets[j].OrderExecuted
+			})
+
+			fmt.Printf("%s:\n", name)
+			if len(changeSets) == 0 {
+				fmt.Println("No change sets found")
+				latest[name] = "-"
+				continue
+			}
+
+			displayLiquibaseChangeSetsTable(changeSets, wideMode)
+
+			summary := summarizeLiquibaseChangeSets(changeSets)
+			latest[name] = summary.latest
+			fmt.Printf("\nLatest applied change set: %s (%d failed)\n", summary.latest, summary.failed)
+		}
+	}
+}
+
+// summarizeLiquibaseChangeSets expects the change sets in execution order
+func summarizeLiquibaseChangeSets(changeSets []actuator.LiquibaseChangeSet) migrationSummary {
+	summary := migrationSummary{latest: "-"}
+	for _, changeSet := range changeSets {
+		switch changeSet.ExecType {
+		case liquibaseExecTypeFailed:
+			summary.failed++
+		case liquibaseExecTypeSkipped:
+		default:
+			summary.latest = changeSet.ID + "::" + changeSet.Author
+		}
+	}
+	return summary
+}
+
+func displayLiquibaseChangeSetsTable(changeSets []actuator.LiquibaseChangeSet, wideMode bool) {
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	if wideMode {
+		_, _ = fmt.Fprintln(w, "ORDER\tID\tAUTHOR\tDESCRIPTION\tEXEC TYPE\tCHECKSUM\tEXECUTED\tTAG\tCHANGELOG")
+	} else {
+		_, _ = fmt.Fprintln(w, "ID\tAUTHOR\tDESCRIPTION\tEXEC TYPE\tCHECKSUM\tEXECUTED")
+	}
+
+	for _, changeSet := range changeSets {
+		if wideMode {
+			_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				changeSet.OrderExecuted, changeSet.ID, changeSet.Author, changeSet.Description, changeSet.ExecType,
+				valueOrDash(changeSet.Checksum), formatMigrationTimestamp(changeSet.DateExecuted),
+				valueOrDash(changeSet.Tag), changeSet.ChangeLog)
+		} else {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				changeSet.ID, changeSet.Author, truncateString(changeSet.Description, maxMigrationDescLength),
+				changeSet.ExecType, valueOrDash(changeSet.Checksum), formatMigrationTimestamp(changeSet.DateExecuted))
+		}
+	}
+}
+
+func formatMigrationTimestamp(s string) string {
+	if s == "" {
+		return "-"
+	}
+	if t := parseTime(s); t != nil {
+		return t.Local().Format(migrationTimestampFormat)
+	}
+	return s
+}
+
+// displayVersionDrift warns if the selected pods report different latest applied migrations for a datasource.
+// Pods that failed or do not use the datasource are not taken into account.
+func (o *migrationsCommandOperations) displayVersionDrift() {
+	if len(o.latest) < 2 {
+		return
+	}
+
+	versionsByDatasource := make(map[string]map[string]string)
+	for pod, latest := range o.latest {
+		for datasource, version := range latest {
+			if versionsByDatasource[datasource] == nil {
+				versionsByDatasource[datasource] = make(map[string]string)
+			}
+			versionsByDatasource[datasource][pod] = version
+		}
+	}
+
+	type driftRow struct{ datasource, pod, version string }
+	var rows []driftRow
+	for _, datasource := range slices.Sorted(maps.Keys(versionsByDatasource)) {
+		versions := versionsByDatasource[datasource]
+		distinct := make(map[string]struct{})
+		for _, version := range versions {
+			distinct[version] = struct{}{}
+		}
+		if len(distinct) < 2 {
+			continue
+		}
+		for _, pod := range slices.Sorted(maps.Keys(versions)) {
+			rows = append(rows, driftRow{datasource: datasource, pod: pod, version: versions[pod]})
+		}
+	}
+
+	if len(rows) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println("Warning: pods report different latest applied migrations")
+
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	_, _ = fmt.Fprintln(w, "DATASOURCE\tPOD\tLATEST")
+	for _, row := range rows {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", row.datasource, row.pod, row.version)
+	}
+}
@@ -0,0 +1,269 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func testFlywayMigration(version string, state string, rank *int) actuator.FlywayMigration {
+	checksum := int64(12345)
+	return actuator.FlywayMigration{
+		Type:          "SQL",
+		Checksum:      &checksum,
+		Version:       version,
+		Description:   "migration " + version,
+		Script:        "V" + version + "__migration.sql",
+		State:         state,
+		InstalledRank: rank,
+	}
+}
+
+func TestSummarizeFlywayMigrations(t *testing.T) {
+	tests := []struct {
+		name        string
+		migrations  []actuator.FlywayMigration
+		wantLatest  string
+		wantPending int
+		wantFailed  int
+	}{
+		{
+			name: "applied and pending",
+			migrations: []actuator.FlywayMigration{
+				testFlywayMigration("1", "SUCCESS", intPtr(1)),
+				testFlywayMigration("2", "SUCCESS", intPtr(2)),
+				testFlywayMigration("3", "PENDING", nil),
+			},
+			wantLatest:  "2",
+			wantPending: 1,
+		},
+		{
+			name: "failed migration is not applied",
+			migrations: []actuator.FlywayMigration{
+				testFlywayMigration("1", "SUCCESS", intPtr(1)),
+				testFlywayMigration("2", "FAILED", intPtr(2)),
+			},
+			wantLatest: "1",
+			wantFailed: 1,
+		},
+		{
+			name: "out of order migration uses installed rank",
+			migrations: []actuator.FlywayMigration{
+				testFlywayMigration("1", "SUCCESS", intPtr(1)),
+				testFlywayMigration("1.5", "OUT_OF_ORDER", intPtr(3)),
+				testFlywayMigration("2", "SUCCESS", intPtr(2)),
+			},
+			wantLatest: "1.5",
+		},
+		{
+			name: "repeatable migrations are ignored",
+			migrations: []actuator.FlywayMigration{
+				testFlywayMigration("1", "SUCCESS", intPtr(1)),
+				testFlywayMigration("", "SUCCESS", intPtr(2)),
+				testFlywayMigration("", "OUTDATED", intPtr(3)),
+			},
+			wantLatest:  "1",
+			wantPending: 1,
+		},
+		{
+			name: "nothing applied",
+			migrations: []actuator.FlywayMigration{
+				testFlywayMigration("1", "PENDING", nil),
+			},
+			wantLatest:  "-",
+			wantPending: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := summarizeFlywayMigrations(tt.migrations)
+			if summary.latest != tt.wantLatest {
+				t.Errorf("latest = %q, want %q", summary.latest, tt.wantLatest)
+			}
+			if summary.pending != tt.wantPending {
+				t.Errorf("pending = %d, want %d", summary.pending, tt.wantPending)
+			}
+			if summary.failed != tt.wantFailed {
+				t.Errorf("failed = %d, want %d", summary.failed, tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestSummarizeLiquibaseChangeSets(t *testing.T) {
+	changeSets := []actuator.LiquibaseChangeSet{
+		{ID: "1", Author: "alice", ExecType: "EXECUTED", OrderExecuted: 1},
+		{ID: "2", Author: "bob", ExecType: "MARK_RAN", OrderExecuted: 2},
+		{ID: "3", Author: "bob", ExecType: "FAILED", OrderExecuted: 3},
+		{ID: "4", Author: "alice", ExecType: "SKIPPED", OrderExecuted: 4},
+	}
+
+	summary := summarizeLiquibaseChangeSets(changeSets)
+	if summary.latest != "2::bob" {
+		t.Errorf("latest = %q, want %q", summary.latest, "2::bob")
+	}
+	if summary.failed != 1 {
+		t.Errorf("failed = %d, want 1", summary.failed)
+	}
+}
+
+func TestDisplayFlyway(t *testing.T) {
+	flyway := &actuator.FlywayResponse{
+		Contexts: map[string]actuator.FlywayContext{
+			"application": {
+				FlywayBeans: map[string]actuator.FlywayBean{
+					"flyway": {
+						Migrations: []actuator.FlywayMigration{
+							testFlywayMigration("1", "SUCCESS", intPtr(1)),
+							testFlywayMigration("2", "PENDING", nil),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		wideMode bool
+		patterns []string
+	}{
+		{
+			name: "default columns",
+			patterns: []string{
+				`Flyway flyway:\n`,
+				`VERSION\s+DESCRIPTION\s+TYPE\s+STATE\s+CHECKSUM\s+INSTALLED ON\n`,
+				`1\s+migration 1\s+SQL\s+SUCCESS\s+12345`,
+				`2\s+migration 2\s+SQL\s+PENDING\s+12345\s+-\n`,
+				`Latest applied version: 1 \(1 pending, 0 failed\)`,
+			},
+		},
+		{
+			name:     "wide columns",
+			wideMode: true,
+			patterns: []string{
+				`RANK\s+VERSION\s+DESCRIPTION\s+TYPE\s+STATE\s+CHECKSUM\s+INSTALLED ON\s+INSTALLED BY\s+EXECUTION TIME\s+SCRIPT\n`,
+				`-\s+2\s+migration 2\s+SQL\s+PENDING\s+12345\s+-\s+-\s+-\s+V2__migration.sql`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			latest := make(map[string]string)
+			output := captureOutput(func() {
+				displayFlyway(flyway, tt.wideMode, latest)
+			})
+
+			for _, pattern := range tt.patterns {
+				if !regexp.MustCompile(pattern).MatchString(output) {
+					t.Errorf("displayFlyway() output does not match %q:\n%s", pattern, output)
+				}
+			}
+			if latest["Flyway flyway"] != "1" {
+				t.Errorf("expected latest version 1 to be recorded, got %v", latest)
+			}
+		})
+	}
+}
+
+func TestDisplayLiquibaseOrdersByExecution(t *testing.T) {
+	liquibase := &actuator.LiquibaseResponse{
+		Contexts: map[string]actuator.LiquibaseContext{
+			"application": {
+				LiquibaseBeans: map[string]actuator.LiquibaseBean{
+					"liquibase": {
+						ChangeSets: []actuator.LiquibaseChangeSet{
+							{ID: "add-index", Author: "bob", Description: "createIndex", ExecType: "EXECUTED", Checksum: "9:bbb", OrderExecuted: 2},
+							{ID: "create-orders", Author: "alice", Description: "createTable tableName=orders", ExecType: "EXECUTED", Checksum: "9:aaa", OrderExecuted: 1},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	latest := make(map[string]string)
+	output := captureOutput(func() {
+		displayLiquibase(liquibase, false, latest)
+	})
+
+	pattern := `(?s)Liquibase liquibase:\nID\s+AUTHOR\s+DESCRIPTION\s+EXEC TYPE\s+CHECKSUM\s+EXECUTED\n` +
+		`create-orders\s+alice\s+createTable tableName=orders\s+EXECUTED\s+9:aaa\s+-\n` +
+		`add-index\s+bob.*Latest applied change set: add-index::bob \(0 failed\)`
+	if !regexp.MustCompile(pattern).MatchString(output) {
+		t.Errorf("displayLiquibase() output does not match %q:\n%s", pattern, output)
+	}
+	if latest["Liquibase liquibase"] != "add-index::bob" {
+		t.Errorf("expected latest change set to be recorded, got %v", latest)
+	}
+}
+
+func TestDisplayVersionDrift(t *testing.T) {
+	tests := []struct {
+		name        string
+		latest      map[string]map[string]string
+		expected    []string
+		notExpected []string
+		pattern     string
+	}{
+		{
+			name: "replicas agree",
+			latest: map[string]map[string]string{
+				"pod-1": {"Flyway flyway": "3"},
+				"pod-2": {"Flyway flyway": "3"},
+			},
+			notExpected: []string{"Warning"},
+		},
+		{
+			name: "single pod",
+			latest: map[string]map[string]string{
+				"pod-1": {"Flyway flyway": "3"},
+			},
+			notExpected: []string{"Warning"},
+		},
+		{
+			name: "replicas differ",
+			latest: map[string]map[string]string{
+				"pod-1": {"Flyway flyway": "3", "Flyway reportingFlyway": "1"},
+				"pod-2": {"Flyway flyway": "2", "Flyway reportingFlyway": "1"},
+			},
+			expected: []string{
+				"Warning: pods report different latest applied migrations",
+				"DATASOURCE",
+			},
+			notExpected: []string{"reportingFlyway"},
+			pattern:     `Flyway flyway\s+pod-1\s+3\nFlyway flyway\s+pod-2\s+2\n`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &migrationsCommandOperations{latest: tt.latest}
+			output := captureOutput(func() {
+				ops.displayVersionDrift()
+			})
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("displayVersionDrift() output missing expected value:\n  want: %s\n  got:\n%s", expected, output)
+				}
+			}
+			for _, notExpected := range tt.notExpected {
+				if strings.Contains(output, notExpected) {
+					t.Errorf("displayVersionDrift() output contains unexpected value:\n  unwanted: %s\n  got:\n%s", notExpected, output)
+				}
+			}
+			if tt.pattern != "" && !regexp.MustCompile(tt.pattern).MatchString(output) {
+				t.Errorf("displayVersionDrift() output does not match %q:\n%s", tt.pattern, output)
+			}
+		})
+	}
+}
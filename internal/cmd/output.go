@@ -10,8 +10,10 @@ import (
 
 // Output format constants
 const (
-	OutputFormatWide = "wide"
-	OutputFormatName = "name"
+	OutputFormatWide   = "wide"
+	OutputFormatName   = "name"
+	OutputFormatFolded = "folded"
+	OutputFormatJstack = "jstack"
 )
 
 // newTableWriter creates a consistently configured tabwriter for table output.
@@ -42,6 +44,31 @@ func formatDurationCompact(d time.Duration) string {
 	return b.String()
 }
 
+// formatDurationPrecise formats a short duration with sub-second precision like "850µs", "23ms" or "1.50s".
+func formatDurationPrecise(d time.Duration) string {
+	switch {
+	case d < time.Millisecond:
+		return fmt.Sprintf("%dµs", d.Microseconds())
+	case d < time.Second:
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	default:
+		return fmt.Sprintf("%.2fs", d.Seconds())
+	}
+}
+
+// formatJSONValue formats a value decoded from JSON. Numbers are decoded as float64, so whole numbers are
+// formatted without an exponent, e.g. 1800000 instead of 1.8e+06.
+func formatJSONValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case float64:
+		return formatSampleValue(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
 // formatSecondsHuman formats seconds as a human-readable string with appropriate units.
 func formatSecondsHuman(seconds float64) string {
 	if seconds < 0.001 {
@@ -0,0 +1,337 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+type prometheusCommandOperations struct {
+	baseOperations
+	output      string
+	openMetrics bool
+	query       string
+	selector    *seriesSelector
+}
+
+func NewPrometheusCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &prometheusCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "prometheus [selector]",
+		Short: "Query the prometheus scrape endpoint",
+		Long: `Query the prometheus scrape endpoint of Spring Boot Actuator.
+
+Fetches all metrics in a single request and lists the series that match
+the selector. The selector uses the Prometheus syntax: a metric name,
+label matchers or both, e.g.
+
+  http_server_requests_seconds_count{status="500"}
+  {uri=~"/api/.*",method!="GET"}
+
+Supported matchers are = (equal), != (not equal), =~ (regex match) and
+!~ (regex does not match). A metric name also matches the samples of its
+family, e.g. http_server_requests_seconds matches its _count and _sum
+samples. Without a selector, all series are listed.
+
+Requires micrometer-registry-prometheus on the classpath.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd, args); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			return RunForEachPod(cmd.Context(), operations.pods, "get prometheus metrics", operations.runForPod)
+		},
+	}
+
+	cmd.Flags().StringVarP(&operations.output, "output", "o", "", "Output format. One of: wide")
+	cmd.Flags().BoolVar(&operations.openMetrics, "openmetrics", false, "Request the OpenMetrics format instead of the Prometheus text format")
+
+	return cmd
+}
+
+func (o *prometheusCommandOperations) complete(cmd *cobra.Command, args []string) error {
+	if err := o.baseOperations.complete(cmd); err != nil {
+		return err
+	}
+
+	if len(args) >= 1 {
+		o.query = args[0]
+	}
+
+	return nil
+}
+
+func (o *prometheusCommandOperations) validate() error {
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+
+	if err := validateOutputFormat(o.output, OutputFormatWide); err != nil {
+		return err
+	}
+
+	selector, err := parseSeriesSelector(o.query)
+	if err != nil {
+		return err
+	}
+	o.selector = selector
+
+	return nil
+}
+
+func (o *prometheusCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	response, err := client.GetPrometheus(o.openMetrics)
+	if err != nil {
+		return err
+	}
+
+	o.displaySeries(response.Families)
+	return nil
+}
+
+func (o *prometheusCommandOperations) displaySeries(families []actuator.PrometheusMetricFamily) {
+	type seriesRow struct {
+		family *actuator.PrometheusMetricFamily
+		sample actuator.PrometheusSample
+	}
+
+	var rows []seriesRow
+	for i := range families {
+		for _, sample := range families[i].Samples {
+			if o.selector.matches(&families[i], sample) {
+				rows = append(rows, seriesRow{family: &families[i], sample: sample})
+			}
+		}
+	}
+
+	if len(rows) == 0 {
+		fmt.Println("No series found")
+		return
+	}
+
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	wide := o.output == OutputFormatWide
+	if wide {
+		_, _ = fmt.Fprintln(w, "SERIES\tVALUE\tTYPE\tHELP")
+	} else {
+		_, _ = fmt.Fprintln(w, "SERIES\tVALUE")
+	}
+
+	for _, row := range rows {
+		series := formatSeries(row.sample.Name, row.sample.Labels)
+		value := formatSampleValue(row.sample.Value)
+		if wide {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", series, value, row.family.Type, valueOrDash(escapeValue(row.family.Help)))
+		} else {
+			_, _ = fmt.Fprintf(w, "%s\t%s\n", series, value)
+		}
+	}
+}
+
+// formatSeries formats a series like Prometheus does, e.g. `requests_total{method="GET",status="200"}`
+func formatSeries(name string, labels map[string]string) string {
+	if len(labels) == 0 {
+		return name
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	escaper := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + `="` + escaper.Replace(labels[key]) + `"`
+	}
+	return name + "{" + strings.Join(pairs, ",") + "}"
+}
+
+// formatSampleValue avoids the exponent notation for whole numbers like large counters
+func formatSampleValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	case value == math.Trunc(value) && math.Abs(value) < 1e15:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
+
+// seriesSelector is a Prometheus series selector like `name{label="value"}`
+type seriesSelector struct {
+	name     string
+	matchers []labelMatcher
+}
+
+type labelMatcher struct {
+	label   string
+	op      string
+	value   string
+	pattern *regexp.Regexp
+}
+
+var (
+	metricNamePattern = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*`)
+	labelNamePattern  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*`)
+)
+
+// parseSeriesSelector parses a selector. An empty selector matches all series.
+func parseSeriesSelector(query string) (*seriesSelector, error) {
+	selector := &seriesSelector{}
+	s := strings.TrimSpace(query)
+
+	selector.name = metricNamePattern.FindString(s)
+	s = strings.TrimSpace(s[len(selector.name):])
+	if s == "" {
+		return selector, nil
+	}
+
+	if !strings.HasPrefix(s, "{") || !strings.HasSuffix(s, "}") {
+		return nil, fmt.Errorf("invalid selector '%s': expected a metric name followed by optional label matchers in braces", query)
+	}
+	s = s[1:]
+
+	for {
+		s = strings.TrimSpace(s)
+		if s == "}" {
+			return selector, nil
+		}
+
+		matcher, rest, err := parseLabelMatcher(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector '%s': %w", query, err)
+		}
+		selector.matchers = append(selector.matchers, matcher)
+
+		rest = strings.TrimSpace(rest)
+		if !strings.HasPrefix(rest, ",") && rest != "}" {
+			return nil, fmt.Errorf("invalid selector '%s': expected ',' or '}' after matcher for %s", query, matcher.label)
+		}
+		s = strings.TrimPrefix(rest, ",")
+	}
+}
+
+// parseLabelMatcher parses a matcher like `status="500"` and returns the text after it
+func parseLabelMatcher(s string) (labelMatcher, string, error) {
+	label := labelNamePattern.FindString(s)
+	if label == "" {
+		return labelMatcher{}, "", fmt.Errorf("expected a label name at '%s'", s)
+	}
+	s = strings.TrimSpace(s[len(label):])
+
+	var op string
+	for _, candidate := range []string{"=~", "!~", "!=", "="} {
+		if strings.HasPrefix(s, candidate) {
+			op = candidate
+			break
+		}
+	}
+	if op == "" {
+		return labelMatcher{}, "", fmt.Errorf("expected one of =, !=, =~, !~ after label %s", label)
+	}
+	s = strings.TrimSpace(s[len(op):])
+
+	value, rest, err := unquoteMatcherValue(s)
+	if err != nil {
+		return labelMatcher{}, "", fmt.Errorf("invalid value for label %s: %w", label, err)
+	}
+
+	matcher := labelMatcher{label: label, op: op, value: value}
+	if op == "=~" || op == "!~" {
+		// Like Prometheus, regular expressions must match the whole label value
+		pattern, err := regexp.Compile("^(?:" + value + ")$")
+		if err != nil {
+			return labelMatcher{}, "", fmt.Errorf("invalid regular expression for label %s: %w", label, err)
+		}
+		matcher.pattern = pattern
+	}
+
+	return matcher, rest, nil
+}
+
+// unquoteMatcherValue reads a value in double or single quotes and returns the text after the closing quote
+func unquoteMatcherValue(s string) (string, string, error) {
+	if s == "" || (s[0] != '"' && s[0] != '\'') {
+		return "", "", fmt.Errorf("value must be quoted")
+	}
+	quote := s[0]
+
+	var value strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == quote:
+			return value.String(), s[i+1:], nil
+		case s[i] == '\\' && i+1 < len(s):
+			i++
+			if s[i] == 'n' {
+				value.WriteByte('\n')
+			} else {
+				value.WriteByte(s[i])
+			}
+		default:
+			value.WriteByte(s[i])
+		}
+	}
+	return "", "", fmt.Errorf("missing closing quote")
+}
+
+func (s *seriesSelector) matches(family *actuator.PrometheusMetricFamily, sample actuator.PrometheusSample) bool {
+	if s.name != "" && s.name != sample.Name && s.name != family.Name {
+		return false
+	}
+
+	for _, matcher := range s.matchers {
+		value := sample.Labels[matcher.label]
+		if matcher.label == "__name__" {
+			value = sample.Name
+		}
+		if !matcher.matches(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m labelMatcher) matches(value string) bool {
+	switch m.op {
+	case "=":
+		return value == m.value
+	case "!=":
+		return value != m.value
+	case "=~":
+		return m.pattern.MatchString(value)
+	default:
+		return !m.pattern.MatchString(value)
+	}
+}
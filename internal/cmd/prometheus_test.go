@@ -0,0 +1,276 @@
+package cmd
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func testPrometheusFamilies() []actuator.PrometheusMetricFamily {
+	return []actuator.PrometheusMetricFamily{
+		{
+			Name: "http_server_requests_seconds",
+			Type: "summary",
+			Help: "Duration of HTTP server request handling",
+			Samples: []actuator.PrometheusSample{
+				{Name: "http_server_requests_seconds_count", Labels: map[string]string{"method": "GET", "status": "200", "uri": "/api/orders"}, Value: 1200},
+				{Name: "http_server_requests_seconds_sum", Labels: map[string]string{"method": "GET", "status": "200", "uri": "/api/orders"}, Value: 84.5},
+				{Name: "http_server_requests_seconds_count", Labels: map[string]string{"method": "POST", "status": "500", "uri": "/api/orders"}, Value: 2},
+				{Name: "http_server_requests_seconds_sum", Labels: map[string]string{"method": "POST", "status": "500", "uri": "/api/orders"}, Value: 1.5},
+				{Name: "http_server_requests_seconds_count", Labels: map[string]string{"method": "GET", "status": "200", "uri": "/actuator/health"}, Value: 40},
+			},
+		},
+		{
+			Name: "jvm_threads_live_threads",
+			Type: "gauge",
+			Samples: []actuator.PrometheusSample{
+				{Name: "jvm_threads_live_threads", Labels: map[string]string{}, Value: 42},
+			},
+		},
+	}
+}
+
+func TestParseSeriesSelector(t *testing.T) {
+	tests := []struct {
+		name         string
+		query        string
+		wantName     string
+		wantMatchers int
+		wantErr      bool
+		errContains  string
+	}{
+		{name: "empty", query: "", wantName: ""},
+		{name: "name only", query: "jvm_threads_live_threads", wantName: "jvm_threads_live_threads"},
+		{name: "name with matcher", query: `http_server_requests_seconds_count{status="500"}`, wantName: "http_server_requests_seconds_count", wantMatchers: 1},
+		{name: "matchers only", query: `{uri=~"/api/.*", method!="GET"}`, wantMatchers: 2},
+		{name: "single quotes and trailing comma", query: `up{job='app',}`, wantName: "up", wantMatchers: 1},
+		{name: "empty braces", query: "up{}", wantName: "up"},
+		{name: "value with braces", query: `{uri="/api/{id}"}`, wantMatchers: 1},
+		{name: "unquoted value", query: "up{job=app}", wantErr: true, errContains: "value must be quoted"},
+		{name: "unknown operator", query: `up{job<"app"}`, wantErr: true, errContains: "expected one of =, !=, =~, !~"},
+		{name: "invalid regex", query: `up{job=~"("}`, wantErr: true, errContains: "invalid regular expression"},
+		{name: "missing closing brace", query: `up{job="app"`, wantErr: true, errContains: "expected a metric name"},
+		{name: "missing comma", query: `up{job="app" env="prod"}`, wantErr: true, errContains: "expected ',' or '}'"},
+		{name: "unterminated value", query: `up{job="app}`, wantErr: true, errContains: "missing closing quote"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := parseSeriesSelector(tt.query)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSeriesSelector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+				}
+				return
+			}
+
+			if selector.name != tt.wantName {
+				t.Errorf("name = %q, want %q", selector.name, tt.wantName)
+			}
+			if len(selector.matchers) != tt.wantMatchers {
+				t.Errorf("expected %d matchers, got %d", tt.wantMatchers, len(selector.matchers))
+			}
+		})
+	}
+}
+
+func TestSeriesSelectorMatches(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{name: "all series", query: "", want: 6},
+		{name: "sample name", query: "http_server_requests_seconds_count", want: 3},
+		{name: "family name", query: "http_server_requests_seconds", want: 5},
+		{name: "equal", query: `http_server_requests_seconds_count{status="500"}`, want: 1},
+		{name: "not equal", query: `http_server_requests_seconds_count{status!="500"}`, want: 2},
+		{name: "regex matches whole value", query: `{uri=~"/api"}`, want: 0},
+		{name: "regex", query: `{uri=~"/api/.*"}`, want: 4},
+		{name: "negative regex", query: `http_server_requests_seconds_count{uri!~"/actuator/.*"}`, want: 2},
+		{name: "missing label is empty", query: `{uri=""}`, want: 1},
+		{name: "name label", query: `{__name__=~".*_sum"}`, want: 2},
+		{name: "several matchers", query: `{method="GET",uri="/api/orders"}`, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := parseSeriesSelector(tt.query)
+			if err != nil {
+				t.Fatalf("parseSeriesSelector() error = %v", err)
+			}
+
+			families := testPrometheusFamilies()
+			matched := 0
+			for i := range families {
+				for _, sample := range families[i].Samples {
+					if selector.matches(&families[i], sample) {
+						matched++
+					}
+				}
+			}
+
+			if matched != tt.want {
+				t.Errorf("matched %d series, want %d", matched, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatSeries(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   string
+	}{
+		{name: "no labels", labels: map[string]string{}, want: "up"},
+		{name: "sorted labels", labels: map[string]string{"status": "500", "method": "GET"}, want: `up{method="GET",status="500"}`},
+		{name: "escaped value", labels: map[string]string{"message": "say \"hi\"\n"}, want: `up{message="say \"hi\"\n"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatSeries("up", tt.labels); got != tt.want {
+				t.Errorf("formatSeries() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatSampleValue(t *testing.T) {
+	tests := []struct {
+		value float64
+		want  string
+	}{
+		{value: 42, want: "42"},
+		{value: 12345678, want: "12345678"},
+		{value: 0.84, want: "0.84"},
+		{value: 1.5e-7, want: "1.5e-07"},
+		{value: 2e20, want: "2e+20"},
+		{value: math.Inf(1), want: "+Inf"},
+		{value: math.Inf(-1), want: "-Inf"},
+		{value: math.NaN(), want: "NaN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := formatSampleValue(tt.value); got != tt.want {
+				t.Errorf("formatSampleValue(%v) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisplaySeries(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		output        string
+		expected      []string
+		notExpected   []string
+		expectedRegex []string
+	}{
+		{
+			name:  "filtered series",
+			query: `http_server_requests_seconds_count{status="500"}`,
+			expectedRegex: []string{
+				`SERIES\s+VALUE`,
+				`http_server_requests_seconds_count\{method="POST",status="500",uri="/api/orders"\}\s+2`,
+			},
+			notExpected: []string{"status=\"200\"", "TYPE", "jvm_threads_live_threads"},
+		},
+		{
+			name:   "wide output",
+			query:  "jvm_threads_live_threads",
+			output: OutputFormatWide,
+			expectedRegex: []string{
+				`SERIES\s+VALUE\s+TYPE\s+HELP`,
+				`jvm_threads_live_threads\s+42\s+gauge\s+-`,
+			},
+		},
+		{
+			name:     "large counter without exponent",
+			query:    `http_server_requests_seconds_count{uri="/api/orders",method="GET"}`,
+			expected: []string{"1200"},
+		},
+		{
+			name:     "no match",
+			query:    "missing_metric",
+			expected: []string{"No series found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := parseSeriesSelector(tt.query)
+			if err != nil {
+				t.Fatalf("parseSeriesSelector() error = %v", err)
+			}
+			ops := &prometheusCommandOperations{output: tt.output, selector: selector}
+
+			output := captureOutput(func() {
+				ops.displaySeries(testPrometheusFamilies())
+			})
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("expected output to contain %q, got:\n%s", expected, output)
+				}
+			}
+			for _, notExpected := range tt.notExpected {
+				if strings.Contains(output, notExpected) {
+					t.Errorf("expected output not to contain %q, got:\n%s", notExpected, output)
+				}
+			}
+			for _, pattern := range tt.expectedRegex {
+				if !regexp.MustCompile(pattern).MatchString(output) {
+					t.Errorf("expected output to match %q, got:\n%s", pattern, output)
+				}
+			}
+		})
+	}
+}
+
+func TestPrometheusValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		pods        []string
+		output      string
+		query       string
+		wantErr     bool
+		errContains string
+	}{
+		{name: "no selector", pods: []string{"pod-1"}},
+		{name: "valid selector", pods: []string{"pod-1"}, query: `up{job="app"}`},
+		{name: "wide output", pods: []string{"pod-1"}, output: "wide"},
+		{name: "invalid output", pods: []string{"pod-1"}, output: "json", wantErr: true, errContains: "not recognized"},
+		{name: "invalid selector", pods: []string{"pod-1"}, query: "up{", wantErr: true, errContains: "invalid selector"},
+		{name: "no pods", pods: []string{}, wantErr: true, errContains: "no pods selected"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &prometheusCommandOperations{
+				baseOperations: baseOperations{pods: tt.pods},
+				output:         tt.output,
+				query:          tt.query,
+			}
+
+			err := ops.validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("validate() error = %v, want error containing %q", err, tt.errContains)
+			}
+		})
+	}
+}
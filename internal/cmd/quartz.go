@@ -0,0 +1,417 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const (
+	maxQuartzClassLength    = 60
+	maxQuartzScheduleLength = 60
+)
+
+// Quartz numbers the days of the week from Sunday (1) to Saturday (7)
+var quartzDaysOfWeek = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+type quartzCommandOperations struct {
+	baseOperations
+	output      string
+	groupFilter string
+}
+
+type quartzTriggerCommandOperations struct {
+	baseOperations
+	group string
+	job   string
+}
+
+func NewQuartzCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &quartzCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "quartz",
+		Short: "List and trigger Quartz jobs",
+		Long: `List Quartz jobs and triggers via Spring Boot Actuator.
+
+Without a subcommand, lists the jobs and triggers of every group, with the
+state, schedule and next and previous fire times of each trigger.
+Use 'quartz trigger' to run a job immediately.
+
+The misfire instruction of a trigger is shown in wide output. Spring Boot's
+quartz endpoint does not report it, so it is only shown when the
+application adds it to the trigger details.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			return RunForEachPod(cmd.Context(), operations.pods, "get quartz jobs", operations.runForPod)
+		},
+	}
+
+	cmd.Flags().StringVarP(&operations.output, "output", "o", "", "Output format. One of: wide")
+	cmd.Flags().StringVar(&operations.groupFilter, "group", "", "Only show jobs and triggers of this group")
+
+	cmd.AddCommand(newQuartzTriggerCommand(configFlags, podResolver))
+
+	return cmd
+}
+
+func newQuartzTriggerCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &quartzTriggerCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "trigger <group> <job>",
+		Short: "Trigger a Quartz job",
+		Long: `Run a Quartz job immediately on every selected pod.
+
+Requires Spring Boot 3.5 or later.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd, args); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			return RunForEachPod(cmd.Context(), operations.pods, "trigger quartz job", operations.runForPod)
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) >= 2 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			if err := operations.baseOperations.complete(cmd); err != nil {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return operations.validArgsJob(cmd.Context(), args)
+		},
+	}
+
+	return cmd
+}
+
+func (o *quartzCommandOperations) validate() error {
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+	return validateOutputFormat(o.output, OutputFormatWide)
+}
+
+func (o *quartzCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	jobs, err := o.fetchJobs(client)
+	if err != nil {
+		return err
+	}
+
+	triggers, err := o.fetchTriggers(client)
+	if err != nil {
+		return err
+	}
+
+	if len(jobs) == 0 && len(triggers) == 0 {
+		if o.groupFilter != "" {
+			fmt.Printf("No Quartz jobs found in group: %s\n", o.groupFilter)
+		} else {
+			fmt.Println("No Quartz jobs found")
+		}
+		return nil
+	}
+
+	wideMode := o.output == OutputFormatWide
+
+	fmt.Println("Jobs:")
+	displayQuartzJobs(jobs, wideMode)
+	fmt.Println()
+	fmt.Println("Triggers:")
+	displayQuartzTriggers(triggers, wideMode)
+	return nil
+}
+
+func (o *quartzCommandOperations) matchesGroup(group string) bool {
+	return o.groupFilter == "" || group == o.groupFilter
+}
+
+// fetchJobs returns the details of every job. The job groups only list job names, so each job is fetched separately.
+func (o *quartzCommandOperations) fetchJobs(client actuator.Client) ([]*actuator.QuartzJobDetail, error) {
+	groups, err := client.GetQuartzJobGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []*actuator.QuartzJobDetail
+	for _, group := range slices.Sorted(maps.Keys(groups.Groups)) {
+		if !o.matchesGroup(group) {
+			continue
+		}
+		names := slices.Clone(groups.Groups[group].Jobs)
+		sort.Strings(names)
+		for _, name := range names {
+			job, err := client.GetQuartzJob(group, name)
+			if err != nil {
+				return nil, err
+			}
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs, nil
+}
+
+// fetchTriggers returns the details of every trigger, ordered by group and name
+func (o *quartzCommandOperations) fetchTriggers(client actuator.Client) ([]*actuator.QuartzTriggerDetail, error) {
+	groups, err := client.GetQuartzTriggerGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	var triggers []*actuator.QuartzTriggerDetail
+	for _, group := range slices.Sorted(maps.Keys(groups.Groups)) {
+		if !o.matchesGroup(group) {
+			continue
+		}
+		var names []string
+		for _, typeNames := range groups.Groups[group].Triggers {
+			names = append(names, typeNames...)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			trigger, err := client.GetQuartzTrigger(group, name)
+			if err != nil {
+				return nil, err
+			}
+			triggers = append(triggers, trigger)
+		}
+	}
+	return triggers, nil
+}
+
+func displayQuartzJobs(jobs []*actuator.QuartzJobDetail, wideMode bool) {
+	if len(jobs) == 0 {
+		fmt.Println("No jobs found")
+		return
+	}
+
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	if wideMode {
+		_, _ = fmt.Fprintln(w, "GROUP\tNAME\tCLASS\tDURABLE\tTRIGGERS\tDESCRIPTION")
+	} else {
+		_, _ = fmt.Fprintln(w, "GROUP\tNAME\tCLASS\tTRIGGERS")
+	}
+
+	for _, job := range jobs {
+		triggerNames := make([]string, 0, len(job.Triggers))
+		for _, trigger := range job.Triggers {
+			if trigger.Group == job.Group {
+				triggerNames = append(triggerNames, trigger.Name)
+			} else {
+				triggerNames = append(triggerNames, trigger.Group+"."+trigger.Name)
+			}
+		}
+		sort.Strings(triggerNames)
+		triggers := valueOrDash(strings.Join(triggerNames, ","))
+
+		if wideMode {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\t%s\n",
+				job.Group, job.Name, job.ClassName, job.Durable, triggers, valueOrDash(job.Description))
+		} else {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+				job.Group, job.Name, shortenType(job.ClassName, maxQuartzClassLength), triggers)
+		}
+	}
+}
+
+func displayQuartzTriggers(triggers []*actuator.QuartzTriggerDetail, wideMode bool) {
+	if len(triggers) == 0 {
+		fmt.Println("No triggers found")
+		return
+	}
+
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	if wideMode {
+		_, _ = fmt.Fprintln(w, "GROUP\tNAME\tTYPE\tSTATE\tSCHEDULE\tNEXT\tLAST\tPRIORITY\tCALENDAR\tMISFIRE")
+	} else {
+		_, _ = fmt.Fprintln(w, "GROUP\tNAME\tTYPE\tSTATE\tSCHEDULE\tNEXT\tLAST")
+	}
+
+	for _, trigger := range triggers {
+		schedule := formatQuartzSchedule(trigger)
+		next := formatRelativeTimestamp(trigger.NextFireTime)
+		last := formatRelativeTimestamp(trigger.PreviousFireTime)
+
+		if wideMode {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+				trigger.Group, trigger.Name, trigger.Type, valueOrDash(trigger.State), schedule, next, last,
+				trigger.Priority, valueOrDash(trigger.CalendarName), formatMisfireInstruction(trigger))
+		} else {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				trigger.Group, trigger.Name, trigger.Type, valueOrDash(trigger.State),
+				truncateString(schedule, maxQuartzScheduleLength), next, last)
+		}
+	}
+}
+
+func formatQuartzSchedule(trigger *actuator.QuartzTriggerDetail) string {
+	switch {
+	case trigger.Cron != nil:
+		schedule := fmt.Sprintf("cron(%s)", trigger.Cron.Expression)
+		if trigger.Cron.TimeZone != "" {
+			schedule += " " + trigger.Cron.TimeZone
+		}
+		return schedule
+	case trigger.Simple != nil:
+		schedule := "interval=" + formatMs(trigger.Simple.Interval)
+		// A repeat count of -1 repeats the trigger indefinitely
+		if trigger.Simple.RepeatCount >= 0 {
+			schedule += fmt.Sprintf(" repeat=%d", trigger.Simple.RepeatCount)
+		}
+		return schedule
+	case trigger.DailyTimeInterval != nil:
+		daily := trigger.DailyTimeInterval
+		schedule := "interval=" + formatMs(daily.Interval)
+		if len(daily.DaysOfWeek) > 0 && len(daily.DaysOfWeek) < len(quartzDaysOfWeek) {
+			schedule += " days=" + formatQuartzDaysOfWeek(daily.DaysOfWeek)
+		}
+		if daily.StartTimeOfDay != "" || daily.EndTimeOfDay != "" {
+			schedule += fmt.Sprintf(" %s-%s", daily.StartTimeOfDay, daily.EndTimeOfDay)
+		}
+		return schedule
+	case trigger.CalendarInterval != nil:
+		schedule := "interval=" + formatMs(trigger.CalendarInterval.Interval)
+		if trigger.CalendarInterval.TimeZone != "" {
+			schedule += " " + trigger.CalendarInterval.TimeZone
+		}
+		return schedule
+	case trigger.Custom != nil:
+		return valueOrDash(trigger.Custom.Trigger)
+	}
+	return "-"
+}
+
+func formatQuartzDaysOfWeek(days []int) string {
+	sorted := slices.Clone(days)
+	sort.Ints(sorted)
+
+	names := make([]string, 0, len(sorted))
+	for _, day := range sorted {
+		if day >= 1 && day <= len(quartzDaysOfWeek) {
+			names = append(names, quartzDaysOfWeek[day-1])
+		} else {
+			names = append(names, strconv.Itoa(day))
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// formatMisfireInstruction names the misfire instruction of a trigger. Except for the smart and
+// ignore policies, the meaning of an instruction depends on the trigger type.
+func formatMisfireInstruction(trigger *actuator.QuartzTriggerDetail) string {
+	if trigger.MisfireInstruction == nil {
+		return "-"
+	}
+
+	instruction := *trigger.MisfireInstruction
+	switch instruction {
+	case -1:
+		return "IGNORE_MISFIRES"
+	case 0:
+		return "SMART_POLICY"
+	}
+
+	var names []string
+	switch trigger.Type {
+	case "simple":
+		names = []string{"FIRE_NOW", "RESCHEDULE_NOW_WITH_EXISTING_REPEAT_COUNT", "RESCHEDULE_NOW_WITH_REMAINING_REPEAT_COUNT",
+			"RESCHEDULE_NEXT_WITH_REMAINING_COUNT", "RESCHEDULE_NEXT_WITH_EXISTING_COUNT"}
+	case "cron", "dailyTimeInterval", "calendarInterval":
+		names = []string{"FIRE_ONCE_NOW", "DO_NOTHING"}
+	}
+	if instruction >= 1 && instruction <= len(names) {
+		return names[instruction-1]
+	}
+	return strconv.Itoa(instruction)
+}
+
+func (o *quartzTriggerCommandOperations) complete(cmd *cobra.Command, args []string) error {
+	if err := o.baseOperations.complete(cmd); err != nil {
+		return err
+	}
+
+	if len(args) >= 2 {
+		o.group = args[0]
+		o.job = args[1]
+	}
+
+	return nil
+}
+
+func (o *quartzTriggerCommandOperations) validate() error {
+	return o.validatePods()
+}
+
+func (o *quartzTriggerCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.TriggerQuartzJob(o.group, o.job); err != nil {
+		return err
+	}
+	fmt.Printf("Job '%s.%s' triggered\n", o.group, o.job)
+	return nil
+}
+
+// validArgsJob completes the group as first and the job name as second argument
+func (o *quartzTriggerCommandOperations) validArgsJob(ctx context.Context, args []string) ([]string, cobra.ShellCompDirective) {
+	if len(o.pods) == 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	client, err := o.actuatorClientFactory.NewClient(ctx, o.pods[0])
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	groups, err := client.GetQuartzJobGroups()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	if len(args) == 0 {
+		return slices.Sorted(maps.Keys(groups.Groups)), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names := slices.Clone(groups.Groups[args[0]].Jobs)
+	sort.Strings(names)
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
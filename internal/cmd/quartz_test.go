@@ -0,0 +1,266 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func TestFormatQuartzSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		trigger *actuator.QuartzTriggerDetail
+		want    string
+	}{
+		{
+			name:    "cron",
+			trigger: &actuator.QuartzTriggerDetail{Cron: &actuator.QuartzCronSchedule{Expression: "0 0 3 * * ?"}},
+			want:    "cron(0 0 3 * * ?)",
+		},
+		{
+			name: "cron with time zone",
+			trigger: &actuator.QuartzTriggerDetail{
+				Cron: &actuator.QuartzCronSchedule{Expression: "0 0 3 * * ?", TimeZone: "Europe/Berlin"},
+			},
+			want: "cron(0 0 3 * * ?) Europe/Berlin",
+		},
+		{
+			name:    "simple repeating forever",
+			trigger: &actuator.QuartzTriggerDetail{Simple: &actuator.QuartzSimpleSchedule{Interval: 3600000, RepeatCount: -1}},
+			want:    "interval=1h",
+		},
+		{
+			name:    "simple with repeat count",
+			trigger: &actuator.QuartzTriggerDetail{Simple: &actuator.QuartzSimpleSchedule{Interval: 90000, RepeatCount: 3}},
+			want:    "interval=1m30s repeat=3",
+		},
+		{
+			name: "daily time interval",
+			trigger: &actuator.QuartzTriggerDetail{DailyTimeInterval: &actuator.QuartzDailyTimeIntervalSchedule{
+				Interval:       1800000,
+				DaysOfWeek:     []int{6, 2},
+				StartTimeOfDay: "09:00:00",
+				EndTimeOfDay:   "17:00:00",
+			}},
+			want: "interval=30m days=Mon,Fri 09:00:00-17:00:00",
+		},
+		{
+			name: "daily time interval on every day",
+			trigger: &actuator.QuartzTriggerDetail{DailyTimeInterval: &actuator.QuartzDailyTimeIntervalSchedule{
+				Interval:   60000,
+				DaysOfWeek: []int{1, 2, 3, 4, 5, 6, 7},
+			}},
+			want: "interval=1m",
+		},
+		{
+			name:    "calendar interval",
+			trigger: &actuator.QuartzTriggerDetail{CalendarInterval: &actuator.QuartzCalendarIntervalSchedule{Interval: 86400000}},
+			want:    "interval=24h",
+		},
+		{
+			name:    "custom",
+			trigger: &actuator.QuartzTriggerDetail{Custom: &actuator.QuartzCustomSchedule{Trigger: "com.example.CustomTrigger@1234"}},
+			want:    "com.example.CustomTrigger@1234",
+		},
+		{
+			name:    "unknown",
+			trigger: &actuator.QuartzTriggerDetail{},
+			want:    "-",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatQuartzSchedule(tt.trigger); got != tt.want {
+				t.Errorf("formatQuartzSchedule() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatMisfireInstruction(t *testing.T) {
+	tests := []struct {
+		name        string
+		triggerType string
+		instruction *int
+		want        string
+	}{
+		{"not reported", "cron", nil, "-"},
+		{"smart policy", "cron", intPtr(0), "SMART_POLICY"},
+		{"ignore misfires", "simple", intPtr(-1), "IGNORE_MISFIRES"},
+		{"cron do nothing", "cron", intPtr(2), "DO_NOTHING"},
+		{"simple fire now", "simple", intPtr(1), "FIRE_NOW"},
+		{"simple reschedule next", "simple", intPtr(4), "RESCHEDULE_NEXT_WITH_REMAINING_COUNT"},
+		{"unknown instruction", "custom", intPtr(1), "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trigger := &actuator.QuartzTriggerDetail{Type: tt.triggerType, MisfireInstruction: tt.instruction}
+			if got := formatMisfireInstruction(trigger); got != tt.want {
+				t.Errorf("formatMisfireInstruction() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisplayQuartzJobs(t *testing.T) {
+	jobs := []*actuator.QuartzJobDetail{
+		{
+			Group:     "samples",
+			Name:      "reportJob",
+			ClassName: "com.example.jobs.ReportJob",
+			Durable:   true,
+			Triggers: []actuator.QuartzJobTrigger{
+				{Group: "samples", Name: "nightly"},
+				{Group: "manual", Name: "adhoc"},
+			},
+		},
+		{
+			Group:       "samples",
+			Name:        "idleJob",
+			ClassName:   "com.example.jobs.IdleJob",
+			Description: "Not scheduled",
+		},
+	}
+
+	tests := []struct {
+		name        string
+		jobs        []*actuator.QuartzJobDetail
+		wideMode    bool
+		expected    []string
+		notExpected []string
+	}{
+		{
+			name: "table output shortens classes",
+			jobs: jobs,
+			expected: []string{
+				"GROUP", "NAME", "CLASS", "TRIGGERS",
+				"reportJob", "c.e.j.ReportJob", "manual.adhoc,nightly",
+			},
+			notExpected: []string{"com.example.jobs.ReportJob", "DURABLE"},
+		},
+		{
+			name:     "wide output shows full classes and descriptions",
+			jobs:     jobs,
+			wideMode: true,
+			expected: []string{"DURABLE", "DESCRIPTION", "com.example.jobs.ReportJob", "Not scheduled", "true"},
+		},
+		{
+			name:     "no jobs",
+			jobs:     nil,
+			expected: []string{"No jobs found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := captureOutput(func() {
+				displayQuartzJobs(tt.jobs, tt.wideMode)
+			})
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("displayQuartzJobs() output missing expected value:\n  want: %s\n  got:\n%s", expected, output)
+				}
+			}
+			for _, notExpected := range tt.notExpected {
+				if strings.Contains(output, notExpected) {
+					t.Errorf("displayQuartzJobs() output contains unexpected value:\n  unwanted: %s\n  got:\n%s", notExpected, output)
+				}
+			}
+		})
+	}
+}
+
+func TestDisplayQuartzTriggers(t *testing.T) {
+	next := time.Now().Add(2*time.Hour + 30*time.Second).UTC().Format(time.RFC3339)
+	previous := time.Now().Add(-22*time.Hour - 30*time.Second).UTC().Format(time.RFC3339)
+
+	triggers := []*actuator.QuartzTriggerDetail{
+		{
+			Group:            "samples",
+			Name:             "nightly",
+			Type:             "cron",
+			State:            "NORMAL",
+			NextFireTime:     next,
+			PreviousFireTime: previous,
+			Priority:         5,
+			CalendarName:     "bankHolidays",
+			Cron:             &actuator.QuartzCronSchedule{Expression: "0 0 3 * * ?"},
+		},
+		{
+			Group:  "samples",
+			Name:   "paused",
+			Type:   "simple",
+			State:  "PAUSED",
+			Simple: &actuator.QuartzSimpleSchedule{Interval: 60000, RepeatCount: -1},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		wideMode    bool
+		expected    []string
+		notExpected []string
+	}{
+		{
+			name: "table output",
+			expected: []string{
+				"GROUP", "NAME", "TYPE", "STATE", "SCHEDULE", "NEXT", "LAST",
+				"nightly", "cron(0 0 3 * * ?)", "NORMAL", "in 2h", "22h3",
+				"paused", "PAUSED", "interval=1m",
+			},
+			notExpected: []string{"PRIORITY", "bankHolidays"},
+		},
+		{
+			name:     "wide output",
+			wideMode: true,
+			expected: []string{"PRIORITY", "CALENDAR", "MISFIRE", "bankHolidays"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := captureOutput(func() {
+				displayQuartzTriggers(triggers, tt.wideMode)
+			})
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("displayQuartzTriggers() output missing expected value:\n  want: %s\n  got:\n%s", expected, output)
+				}
+			}
+			for _, notExpected := range tt.notExpected {
+				if strings.Contains(output, notExpected) {
+					t.Errorf("displayQuartzTriggers() output contains unexpected value:\n  unwanted: %s\n  got:\n%s", notExpected, output)
+				}
+			}
+		})
+	}
+}
+
+func TestQuartzMatchesGroup(t *testing.T) {
+	tests := []struct {
+		name        string
+		groupFilter string
+		group       string
+		want        bool
+	}{
+		{"no filter", "", "samples", true},
+		{"matching group", "samples", "samples", true},
+		{"other group", "samples", "DEFAULT", false},
+		{"filter is not a prefix match", "sample", "samples", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &quartzCommandOperations{groupFilter: tt.groupFilter}
+			if got := o.matchesGroup(tt.group); got != tt.want {
+				t.Errorf("matchesGroup(%q) = %v, want %v", tt.group, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const unsetPropertyValue = "<unset>"
+
+type refreshCommandOperations struct {
+	baseOperations
+	bus  bool
+	diff bool
+	// refreshed holds the changed keys and the property values after the refresh per pod, used to detect
+	// replicas that ended up with different values
+	refreshed map[string]refreshResult
+}
+
+type refreshResult struct {
+	changedKeys []string
+	properties  map[string]effectiveProperty
+}
+
+func NewRefreshCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &refreshCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "refresh",
+		Short: "Refresh the configuration of Spring Cloud applications",
+		Long: `Refresh the configuration of Spring Cloud applications.
+
+Triggers the refresh endpoint of every selected pod and lists the keys of
+the properties that changed.
+
+With --diff, the environment is read before and after the refresh to show
+the old and new value of each changed property. If the selected pods end
+up with different values, a warning lists them per pod. Values are only
+shown if the env endpoint reveals them (management.endpoint.env.show-values).
+
+With --bus, a refresh event is published to the Spring Cloud Bus through
+the first selected pod instead. The bus refreshes all connected instances
+asynchronously and does not report the changed keys.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			if operations.bus {
+				return RunForEachPod(cmd.Context(), operations.pods[:1], "publish refresh event", operations.runBusRefresh)
+			}
+			err := RunForEachPod(cmd.Context(), operations.pods, "refresh", operations.runForPod)
+			operations.displayValueDrift()
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&operations.bus, "bus", false, "Publish a refresh event to the Spring Cloud Bus")
+	cmd.Flags().BoolVar(&operations.diff, "diff", false, "Show old and new values of the changed properties")
+
+	return cmd
+}
+
+func (o *refreshCommandOperations) validate() error {
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+
+	if o.bus && o.diff {
+		return fmt.Errorf("--diff cannot be used with --bus: the bus refreshes instances asynchronously")
+	}
+
+	return nil
+}
+
+func (o *refreshCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	if !o.diff {
+		changedKeys, err := client.Refresh()
+		if err != nil {
+			return err
+		}
+		displayChangedKeys(changedKeys)
+		return nil
+	}
+
+	before, err := client.GetEnv()
+	if err != nil {
+		return err
+	}
+
+	changedKeys, err := client.Refresh()
+	if err != nil {
+		return err
+	}
+
+	after, err := client.GetEnv()
+	if err != nil {
+		return err
+	}
+
+	result := refreshResult{changedKeys: changedKeys, properties: effectiveProperties(after)}
+	if o.refreshed == nil {
+		o.refreshed = make(map[string]refreshResult)
+	}
+	o.refreshed[podName] = result
+
+	displayPropertyDiff(changedKeys, effectiveProperties(before), result.properties)
+	return nil
+}
+
+func (o *refreshCommandOperations) runBusRefresh(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	if err := client.BusRefresh(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Refresh event published to the bus via pod %s\n", podName)
+	return nil
+}
+
+func displayChangedKeys(changedKeys []string) {
+	if len(changedKeys) == 0 {
+		fmt.Println("No properties changed")
+		return
+	}
+
+	sorted := slices.Clone(changedKeys)
+	sort.Strings(sorted)
+
+	fmt.Printf("Changed properties: %d\n", len(sorted))
+	for _, key := range sorted {
+		fmt.Printf("  %s\n", key)
+	}
+}
+
+type effectiveProperty struct {
+	value  string
+	source string
+}
+
+// effectiveProperties resolves the value of every property. Property sources are listed in order of
+// precedence, so the first source that contains a property determines its value.
+func effectiveProperties(env *actuator.EnvResponse) map[string]effectiveProperty {
+	properties := make(map[string]effectiveProperty)
+	for _, source := range env.PropertySources {
+		for key, details := range source.Properties {
+			if _, exists := properties[key]; exists {
+				continue
+			}
+			properties[key] = effectiveProperty{value: escapeValue(formatJSONValue(details.Value)), source: source.Name}
+		}
+	}
+	return properties
+}
+
+func displayPropertyDiff(changedKeys []string, before, after map[string]effectiveProperty) {
+	if len(changedKeys) == 0 {
+		fmt.Println("No properties changed")
+		return
+	}
+
+	sorted := slices.Clone(changedKeys)
+	sort.Strings(sorted)
+
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	_, _ = fmt.Fprintln(w, "KEY\tOLD\tNEW\tSOURCE")
+	for _, key := range sorted {
+		oldValue, newValue, source := unsetPropertyValue, unsetPropertyValue, "-"
+		if property, ok := before[key]; ok {
+			oldValue = property.value
+		}
+		if property, ok := after[key]; ok {
+			newValue = property.value
+			source = property.source
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", key, oldValue, newValue, source)
+	}
+}
+
+// displayValueDrift warns if the selected pods have different values for a property that changed on any of them
+func (o *refreshCommandOperations) displayValueDrift() {
+	if len(o.refreshed) < 2 {
+		return
+	}
+
+	changedKeys := make(map[string]struct{})
+	for _, result := range o.refreshed {
+		for _, key := range result.changedKeys {
+			changedKeys[key] = struct{}{}
+		}
+	}
+
+	type driftRow struct{ key, pod, value string }
+	var rows []driftRow
+	for _, key := range slices.Sorted(maps.Keys(changedKeys)) {
+		values := make(map[string]string, len(o.refreshed))
+		distinct := make(map[string]struct{})
+		for pod, result := range o.refreshed {
+			value := unsetPropertyValue
+			if property, ok := result.properties[key]; ok {
+				value = property.value
+			}
+			values[pod] = value
+			distinct[value] = struct{}{}
+		}
+		if len(distinct) < 2 {
+			continue
+		}
+		for _, pod := range slices.Sorted(maps.Keys(values)) {
+			rows = append(rows, driftRow{key: key, pod: pod, value: values[pod]})
+		}
+	}
+
+	if len(rows) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println("Warning: pods report different values for refreshed properties")
+
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	_, _ = fmt.Fprintln(w, "KEY\tPOD\tVALUE")
+	for _, row := range rows {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", row.key, row.pod, row.value)
+	}
+}
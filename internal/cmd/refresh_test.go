@@ -0,0 +1,249 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func TestEffectiveProperties(t *testing.T) {
+	env := &actuator.EnvResponse{
+		PropertySources: []actuator.PropertySource{
+			{
+				Name: "configServer:app.yml",
+				Properties: map[string]actuator.PropertyDetails{
+					"app.greeting": {Value: "hi"},
+				},
+			},
+			{
+				Name: "Config resource 'class path resource [application.yml]'",
+				Properties: map[string]actuator.PropertyDetails{
+					"app.greeting": {Value: "hello"},
+					"app.timeout":  {Value: 30},
+					"app.optional": {Value: nil},
+					"app.lifetime": {Value: float64(1800000)},
+				},
+			},
+		},
+	}
+
+	properties := effectiveProperties(env)
+
+	tests := []struct {
+		key        string
+		wantValue  string
+		wantSource string
+	}{
+		{"app.greeting", "hi", "configServer:app.yml"},
+		{"app.timeout", "30", "Config resource 'class path resource [application.yml]'"},
+		{"app.optional", "null", "Config resource 'class path resource [application.yml]'"},
+		{"app.lifetime", "1800000", "Config resource 'class path resource [application.yml]'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			property, ok := properties[tt.key]
+			if !ok {
+				t.Fatalf("property %s not found", tt.key)
+			}
+			if property.value != tt.wantValue {
+				t.Errorf("value = %q, want %q", property.value, tt.wantValue)
+			}
+			if property.source != tt.wantSource {
+				t.Errorf("source = %q, want %q", property.source, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestDisplayChangedKeys(t *testing.T) {
+	tests := []struct {
+		name        string
+		changedKeys []string
+		expected    []string
+		pattern     string
+	}{
+		{
+			name:        "changed keys are sorted",
+			changedKeys: []string{"config.client.version", "app.greeting"},
+			expected:    []string{"Changed properties: 2"},
+			pattern:     `  app.greeting\n  config.client.version\n`,
+		},
+		{
+			name:     "nothing changed",
+			expected: []string{"No properties changed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := captureOutput(func() {
+				displayChangedKeys(tt.changedKeys)
+			})
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("displayChangedKeys() output missing expected value:\n  want: %s\n  got:\n%s", expected, output)
+				}
+			}
+			if tt.pattern != "" && !regexp.MustCompile(tt.pattern).MatchString(output) {
+				t.Errorf("displayChangedKeys() output does not match %q:\n%s", tt.pattern, output)
+			}
+		})
+	}
+}
+
+func TestDisplayPropertyDiff(t *testing.T) {
+	before := map[string]effectiveProperty{
+		"app.greeting": {value: "hello", source: "application.yml"},
+		"app.removed":  {value: "old", source: "configServer:app.yml"},
+	}
+	after := map[string]effectiveProperty{
+		"app.greeting": {value: "hi", source: "configServer:app.yml"},
+		"app.added":    {value: "new", source: "configServer:app.yml"},
+	}
+
+	tests := []struct {
+		name        string
+		changedKeys []string
+		expected    []string
+		patterns    []string
+	}{
+		{
+			name:        "old and new values",
+			changedKeys: []string{"app.greeting", "app.added", "app.removed"},
+			expected:    []string{"KEY", "OLD", "NEW", "SOURCE"},
+			patterns: []string{
+				`app.added\s+<unset>\s+new\s+configServer:app.yml`,
+				`app.greeting\s+hello\s+hi\s+configServer:app.yml`,
+				`app.removed\s+old\s+<unset>\s+-`,
+			},
+		},
+		{
+			name:     "nothing changed",
+			expected: []string{"No properties changed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := captureOutput(func() {
+				displayPropertyDiff(tt.changedKeys, before, after)
+			})
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("displayPropertyDiff() output missing expected value:\n  want: %s\n  got:\n%s", expected, output)
+				}
+			}
+			for _, pattern := range tt.patterns {
+				if !regexp.MustCompile(pattern).MatchString(output) {
+					t.Errorf("displayPropertyDiff() output does not match %q:\n%s", pattern, output)
+				}
+			}
+		})
+	}
+}
+
+func TestDisplayValueDrift(t *testing.T) {
+	tests := []struct {
+		name        string
+		refreshed   map[string]refreshResult
+		expected    []string
+		notExpected []string
+		pattern     string
+	}{
+		{
+			name: "replicas agree",
+			refreshed: map[string]refreshResult{
+				"pod-1": {changedKeys: []string{"app.greeting"}, properties: map[string]effectiveProperty{"app.greeting": {value: "hi"}}},
+				"pod-2": {changedKeys: []string{"app.greeting"}, properties: map[string]effectiveProperty{"app.greeting": {value: "hi"}}},
+			},
+			notExpected: []string{"Warning"},
+		},
+		{
+			name: "replica refreshed earlier agrees",
+			refreshed: map[string]refreshResult{
+				"pod-1": {changedKeys: []string{"app.greeting"}, properties: map[string]effectiveProperty{"app.greeting": {value: "hi"}}},
+				"pod-2": {properties: map[string]effectiveProperty{"app.greeting": {value: "hi"}}},
+			},
+			notExpected: []string{"Warning"},
+		},
+		{
+			name: "single pod",
+			refreshed: map[string]refreshResult{
+				"pod-1": {changedKeys: []string{"app.greeting"}, properties: map[string]effectiveProperty{"app.greeting": {value: "hi"}}},
+			},
+			notExpected: []string{"Warning"},
+		},
+		{
+			name: "replica missed the change",
+			refreshed: map[string]refreshResult{
+				"pod-1": {
+					changedKeys: []string{"app.greeting"},
+					properties:  map[string]effectiveProperty{"app.greeting": {value: "hi"}, "app.timeout": {value: "30"}},
+				},
+				"pod-2": {properties: map[string]effectiveProperty{"app.greeting": {value: "hello"}, "app.timeout": {value: "10"}}},
+			},
+			expected:    []string{"Warning: pods report different values for refreshed properties", "KEY"},
+			notExpected: []string{"app.timeout"},
+			pattern:     `app.greeting\s+pod-1\s+hi\napp.greeting\s+pod-2\s+hello\n`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &refreshCommandOperations{refreshed: tt.refreshed}
+			output := captureOutput(func() {
+				ops.displayValueDrift()
+			})
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("displayValueDrift() output missing expected value:\n  want: %s\n  got:\n%s", expected, output)
+				}
+			}
+			for _, notExpected := range tt.notExpected {
+				if strings.Contains(output, notExpected) {
+					t.Errorf("displayValueDrift() output contains unexpected value:\n  unwanted: %s\n  got:\n%s", notExpected, output)
+				}
+			}
+			if tt.pattern != "" && !regexp.MustCompile(tt.pattern).MatchString(output) {
+				t.Errorf("displayValueDrift() output does not match %q:\n%s", tt.pattern, output)
+			}
+		})
+	}
+}
+
+func TestRefreshValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		pods        []string
+		bus         bool
+		diff        bool
+		wantErr     bool
+		errContains string
+	}{
+		{name: "refresh", pods: []string{"pod-1"}},
+		{name: "refresh with diff", pods: []string{"pod-1"}, diff: true},
+		{name: "bus refresh", pods: []string{"pod-1", "pod-2"}, bus: true},
+		{name: "no pods", wantErr: true, errContains: "no pods selected"},
+		{name: "diff with bus", pods: []string{"pod-1"}, bus: true, diff: true, wantErr: true, errContains: "--diff cannot be used with --bus"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &refreshCommandOperations{baseOperations: baseOperations{pods: tt.pods}, bus: tt.bus, diff: tt.diff}
+			err := o.validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+			}
+		})
+	}
+}
@@ -190,31 +190,32 @@ func parseTime(s string) *time.Time {
 }
 
 func formatRelativeTime(ti *actuator.TimeOnly) string {
-	if ti == nil || ti.Time == "" {
+	if ti == nil {
 		return "-"
 	}
-	if t := parseTime(ti.Time); t != nil {
-		d := time.Until(*t)
-		if d >= 0 {
-			return "in " + formatDurationCompact(d)
-		}
-		return formatDurationCompact(-d) + " ago"
-	}
-	return ti.Time
+	return formatRelativeTimestamp(ti.Time)
 }
 
 func formatRelativeTimeExec(ex *actuator.Execution) string {
-	if ex == nil || ex.Time == "" {
+	if ex == nil {
 		return "-"
 	}
-	if t := parseTime(ex.Time); t != nil {
-		d := time.Since(*t)
+	return formatRelativeTimestamp(ex.Time)
+}
+
+// formatRelativeTimestamp formats an RFC3339 timestamp relative to now (e.g. "in 5m" or "2h ago")
+func formatRelativeTimestamp(s string) string {
+	if s == "" {
+		return "-"
+	}
+	if t := parseTime(s); t != nil {
+		d := time.Until(*t)
 		if d >= 0 {
-			return formatDurationCompact(d) + " ago"
+			return "in " + formatDurationCompact(d)
 		}
-		return "in " + formatDurationCompact(-d)
+		return formatDurationCompact(-d) + " ago"
 	}
-	return ex.Time
+	return s
 }
 
 func formatStatus(ex *actuator.Execution, showFullStatus bool) string {
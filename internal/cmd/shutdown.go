@@ -0,0 +1,213 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const defaultReplacementTimeout = 5 * time.Minute
+
+type shutdownCommandOperations struct {
+	baseOperations
+	yes        bool
+	oneAtATime bool
+	timeout    time.Duration
+	in         io.Reader
+	out        io.Writer
+	// shutdownPod requests the shutdown of a single pod; replaced in tests
+	shutdownPod func(ctx context.Context, podName string) error
+}
+
+func NewShutdownCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &shutdownCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+		in:  os.Stdin,
+		out: os.Stdout,
+	}
+	operations.shutdownPod = operations.runForPod
+
+	cmd := &cobra.Command{
+		Use:   "shutdown",
+		Short: "Shut down the application",
+		Long: `Gracefully shut down the application via Spring Boot Actuator.
+
+Asks for confirmation before shutting down the selected pods, unless
+--yes is given. Requires the shutdown endpoint to be enabled with
+management.endpoint.shutdown.access=unrestricted.
+
+With --one-at-a-time, the pods are shut down one after another. After
+each shutdown, the command waits until the pod's Deployment has a ready
+replacement: either the pod itself after Kubernetes restarted its
+container, or a new pod. All selected pods must belong to a Deployment.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			return operations.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().BoolVarP(&operations.yes, "yes", "y", false, "Skip the confirmation prompt")
+	cmd.Flags().BoolVar(&operations.oneAtATime, "one-at-a-time", false, "Wait for a ready replacement of each pod before shutting down the next one")
+	cmd.Flags().DurationVar(&operations.timeout, "timeout", defaultReplacementTimeout, "Maximum time to wait for a replacement pod with --one-at-a-time")
+
+	return cmd
+}
+
+func (o *shutdownCommandOperations) validate() error {
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+
+	if o.timeout <= 0 {
+		return fmt.Errorf("--timeout must be positive")
+	}
+
+	return nil
+}
+
+func (o *shutdownCommandOperations) run(ctx context.Context) error {
+	if o.oneAtATime {
+		// Resolve all deployments up front, so an unmanaged pod is reported before anything is shut down
+		deployments, err := o.resolveDeployments(ctx)
+		if err != nil {
+			return err
+		}
+		if !o.confirm() {
+			return nil
+		}
+		return o.runOneAtATime(ctx, deployments)
+	}
+
+	if !o.confirm() {
+		return nil
+	}
+	return RunForEachPod(ctx, o.pods, "shut down", o.shutdownPod)
+}
+
+// confirm asks whether the selected pods should be shut down. Anything but "y" or "yes" cancels.
+func (o *shutdownCommandOperations) confirm() bool {
+	if o.yes {
+		return true
+	}
+
+	_, _ = fmt.Fprintf(o.out, "The following %d pod(s) will be shut down:\n", len(o.pods))
+	for _, pod := range o.pods {
+		_, _ = fmt.Fprintf(o.out, "  %s\n", pod)
+	}
+	_, _ = fmt.Fprint(o.out, "Continue? [y/N]: ")
+
+	answer, _ := bufio.NewReader(o.in).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	if answer == "y" || answer == "yes" {
+		return true
+	}
+
+	_, _ = fmt.Fprintln(o.out, "Shutdown cancelled")
+	return false
+}
+
+func (o *shutdownCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	response, err := client.Shutdown()
+	if err != nil {
+		return err
+	}
+
+	if response.Message != "" {
+		_, _ = fmt.Fprintf(o.out, "Shutdown requested: %s\n", response.Message)
+	} else {
+		_, _ = fmt.Fprintln(o.out, "Shutdown requested")
+	}
+	return nil
+}
+
+func (o *shutdownCommandOperations) resolveDeployments(ctx context.Context) (map[string]string, error) {
+	deployments := make(map[string]string, len(o.pods))
+	for _, pod := range o.pods {
+		deployment, err := o.k8sClient.GetPodDeployment(ctx, o.k8sClient.Namespace(), pod)
+		if err != nil {
+			return nil, fmt.Errorf("--one-at-a-time requires pods managed by a Deployment: %w", err)
+		}
+		deployments[pod] = deployment
+	}
+	return deployments, nil
+}
+
+// runOneAtATime shuts down the pods in order and stops at the first pod that fails or is not
+// replaced in time, so that the remaining replicas keep serving.
+func (o *shutdownCommandOperations) runOneAtATime(ctx context.Context, deployments map[string]string) error {
+	for i, pod := range o.pods {
+		if i > 0 {
+			_, _ = fmt.Fprintln(o.out)
+		}
+		_, _ = fmt.Fprintf(o.out, "%s:\n", pod)
+
+		if err := o.shutdownAndWait(ctx, pod, deployments[pod]); err != nil {
+			if remaining := len(o.pods) - i - 1; remaining > 0 {
+				return fmt.Errorf("%s: %w (%d remaining pod(s) not shut down)", pod, err, remaining)
+			}
+			return fmt.Errorf("%s: %w", pod, err)
+		}
+	}
+	return nil
+}
+
+func (o *shutdownCommandOperations) shutdownAndWait(ctx context.Context, podName string, deployment string) error {
+	namespace := o.k8sClient.Namespace()
+
+	// Remember the pod and its siblings to tell a replacement apart from the pods that already existed
+	replaced, err := o.k8sClient.GetPod(ctx, namespace, podName)
+	if err != nil {
+		return err
+	}
+	existingPods, err := o.k8sClient.GetDeploymentPods(ctx, namespace, deployment)
+	if err != nil {
+		return err
+	}
+
+	if err := o.shutdownPod(ctx, podName); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(o.out, "Waiting for a ready replacement in deployment %s...\n", deployment)
+
+	waitCtx, cancel := context.WithTimeout(ctx, o.timeout)
+	defer cancel()
+
+	start := time.Now()
+	replacement, err := o.k8sClient.WaitForReplacementPod(waitCtx, namespace, deployment, replaced, existingPods)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			return fmt.Errorf("no ready replacement after %s", o.timeout)
+		}
+		return err
+	}
+
+	if replacement == podName {
+		_, _ = fmt.Fprintf(o.out, "Pod %s is ready again after restart (%s)\n", replacement, formatDurationCompact(time.Since(start)))
+	} else {
+		_, _ = fmt.Fprintf(o.out, "Replacement pod %s is ready (%s)\n", replacement, formatDurationCompact(time.Since(start)))
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// shutdownK8sClient simulates deployments whose pods are replaced after a shutdown
+type shutdownK8sClient struct {
+	*mockK8sClient
+	podDeployments map[string]string // pod name -> deployment name
+	replacements   map[string]string // pod name -> name of the replacing pod
+	waited         []string
+}
+
+func (m *shutdownK8sClient) GetPod(_ context.Context, namespace, name string) (*corev1.Pod, error) {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}, nil
+}
+
+func (m *shutdownK8sClient) GetPodDeployment(_ context.Context, _, podName string) (string, error) {
+	if deployment, ok := m.podDeployments[podName]; ok {
+		return deployment, nil
+	}
+	return "", fmt.Errorf("pod %s is not managed by a Deployment", podName)
+}
+
+func (m *shutdownK8sClient) WaitForReplacementPod(ctx context.Context, _, _ string, replaced *corev1.Pod, _ []string) (string, error) {
+	m.waited = append(m.waited, replaced.Name)
+	if replacement, ok := m.replacements[replaced.Name]; ok {
+		return replacement, nil
+	}
+	<-ctx.Done()
+	return "", ctx.Err()
+}
+
+func TestShutdownConfirm(t *testing.T) {
+	tests := []struct {
+		name    string
+		yes     bool
+		input   string
+		want    bool
+		wantOut []string
+	}{
+		{name: "yes flag skips prompt", yes: true, want: true},
+		{name: "answer y", input: "y\n", want: true, wantOut: []string{"pod-1", "pod-2", "Continue? [y/N]"}},
+		{name: "answer YES", input: "YES\n", want: true},
+		{name: "answer n", input: "n\n", want: false, wantOut: []string{"Shutdown cancelled"}},
+		{name: "empty answer", input: "\n", want: false},
+		{name: "no input", input: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			o := &shutdownCommandOperations{
+				baseOperations: baseOperations{pods: []string{"pod-1", "pod-2"}},
+				yes:            tt.yes,
+				in:             strings.NewReader(tt.input),
+				out:            &out,
+			}
+
+			if got := o.confirm(); got != tt.want {
+				t.Errorf("confirm() = %v, want %v", got, tt.want)
+			}
+			if tt.yes && out.Len() > 0 {
+				t.Errorf("expected no prompt with --yes, got:\n%s", out.String())
+			}
+			for _, expected := range tt.wantOut {
+				if !strings.Contains(out.String(), expected) {
+					t.Errorf("output missing %q, got:\n%s", expected, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestShutdownValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		pods        []string
+		timeout     time.Duration
+		wantErr     bool
+		errContains string
+	}{
+		{name: "valid", pods: []string{"pod-1"}, timeout: time.Minute},
+		{name: "no pods", timeout: time.Minute, wantErr: true, errContains: "no pods selected"},
+		{name: "zero timeout", pods: []string{"pod-1"}, timeout: 0, wantErr: true, errContains: "--timeout must be positive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &shutdownCommandOperations{baseOperations: baseOperations{pods: tt.pods}, timeout: tt.timeout}
+			err := o.validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+			}
+		})
+	}
+}
+
+func TestShutdownOneAtATime(t *testing.T) {
+	tests := []struct {
+		name           string
+		pods           []string
+		podDeployments map[string]string
+		replacements   map[string]string
+		failShutdown   string
+		wantShutdown   []string
+		wantErr        bool
+		errContains    string
+		wantOut        []string
+	}{
+		{
+			name:           "pods replaced in order",
+			pods:           []string{"my-app-a", "my-app-b"},
+			podDeployments: map[string]string{"my-app-a": "my-app", "my-app-b": "my-app"},
+			replacements:   map[string]string{"my-app-a": "my-app-c", "my-app-b": "my-app-b"},
+			wantShutdown:   []string{"my-app-a", "my-app-b"},
+			wantOut: []string{
+				"Replacement pod my-app-c is ready",
+				"Pod my-app-b is ready again after restart",
+			},
+		},
+		{
+			name:           "pod without deployment is rejected before any shutdown",
+			pods:           []string{"my-app-a", "bare-pod"},
+			podDeployments: map[string]string{"my-app-a": "my-app"},
+			wantErr:        true,
+			errContains:    "bare-pod is not managed by a Deployment",
+		},
+		{
+			name:           "stops when a replacement does not become ready",
+			pods:           []string{"my-app-a", "my-app-b", "my-app-c"},
+			podDeployments: map[string]string{"my-app-a": "my-app", "my-app-b": "my-app", "my-app-c": "my-app"},
+			replacements:   map[string]string{"my-app-b": "my-app-d"},
+			wantShutdown:   []string{"my-app-a"},
+			wantErr:        true,
+			errContains:    "my-app-a: no ready replacement after 50ms (2 remaining pod(s) not shut down)",
+		},
+		{
+			name:           "stops when the shutdown fails",
+			pods:           []string{"my-app-a", "my-app-b"},
+			podDeployments: map[string]string{"my-app-a": "my-app", "my-app-b": "my-app"},
+			replacements:   map[string]string{"my-app-a": "my-app-c", "my-app-b": "my-app-d"},
+			failShutdown:   "my-app-b",
+			wantShutdown:   []string{"my-app-a", "my-app-b"},
+			wantErr:        true,
+			errContains:    "my-app-b: shutdown endpoint not enabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := &shutdownK8sClient{
+				mockK8sClient:  newMockK8sClient(),
+				podDeployments: tt.podDeployments,
+				replacements:   tt.replacements,
+			}
+
+			var out bytes.Buffer
+			var shutdown []string
+			o := &shutdownCommandOperations{
+				baseOperations: baseOperations{pods: tt.pods, k8sClient: k8sClient},
+				yes:            true,
+				oneAtATime:     true,
+				timeout:        50 * time.Millisecond,
+				out:            &out,
+				shutdownPod: func(_ context.Context, podName string) error {
+					shutdown = append(shutdown, podName)
+					if podName == tt.failShutdown {
+						return errors.New("shutdown endpoint not enabled")
+					}
+					return nil
+				},
+			}
+
+			err := o.run(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("error %q does not contain %q", err.Error(), tt.errContains)
+			}
+
+			if strings.Join(shutdown, ",") != strings.Join(tt.wantShutdown, ",") {
+				t.Errorf("shut down pods = %v, want %v", shutdown, tt.wantShutdown)
+			}
+			for _, expected := range tt.wantOut {
+				if !strings.Contains(out.String(), expected) {
+					t.Errorf("output missing %q, got:\n%s", expected, out.String())
+				}
+			}
+		})
+	}
+}
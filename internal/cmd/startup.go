@@ -0,0 +1,298 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const (
+	defaultStartupTop    = 20
+	maxStartupTagsLength = 80
+	sortByDuration       = "duration"
+	sortBySelf           = "self"
+)
+
+type startupCommandOperations struct {
+	baseOperations
+	output      string
+	top         int
+	topSet      bool
+	tree        bool
+	sortBy      string
+	minDuration time.Duration
+}
+
+func NewStartupCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &startupCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "startup",
+		Short: "Show application startup steps",
+		Long: `Show the application startup steps recorded by Spring Boot Actuator.
+
+Requires the application to be started with a BufferingApplicationStartup.
+The steps are read without draining the startup buffer.
+
+By default, the slowest steps are listed. SELF is the time spent in a step
+excluding its child steps.
+
+Use --tree to show the nesting of all steps, or -o folded to print
+folded stacks of the self time (in microseconds) for flame graph tools.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			return RunForEachPod(cmd.Context(), operations.pods, "get startup", operations.runForPod)
+		},
+	}
+
+	cmd.Flags().StringVarP(&operations.output, "output", "o", "", "Output format. One of: wide, folded")
+	cmd.Flags().IntVar(&operations.top, "top", defaultStartupTop, "Number of slowest steps to show")
+	cmd.Flags().BoolVar(&operations.tree, "tree", false, "Show steps as a tree of parent and child steps")
+	cmd.Flags().StringVar(&operations.sortBy, "sort-by", sortByDuration, "Rank steps by: duration, self")
+	cmd.Flags().DurationVar(&operations.minDuration, "min-duration", 0, "Hide steps that took less than this (e.g., 10ms)")
+
+	return cmd
+}
+
+func (o *startupCommandOperations) complete(cmd *cobra.Command) error {
+	if err := o.baseOperations.complete(cmd); err != nil {
+		return err
+	}
+
+	o.topSet = cmd.Flags().Changed("top")
+
+	return nil
+}
+
+func (o *startupCommandOperations) validate() error {
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+
+	if err := validateOutputFormat(o.output, OutputFormatWide, OutputFormatFolded); err != nil {
+		return err
+	}
+
+	if o.top < 1 {
+		return fmt.Errorf("--top must be at least 1")
+	}
+
+	if o.sortBy != sortByDuration && o.sortBy != sortBySelf {
+		return fmt.Errorf("invalid sort field '%s'. Must be one of: %s, %s", o.sortBy, sortByDuration, sortBySelf)
+	}
+
+	if o.minDuration < 0 {
+		return fmt.Errorf("--min-duration must not be negative")
+	}
+
+	if o.tree && o.output == OutputFormatFolded {
+		return fmt.Errorf("--tree cannot be used with -o %s", OutputFormatFolded)
+	}
+
+	if o.topSet && (o.tree || o.output == OutputFormatFolded) {
+		return fmt.Errorf("--top cannot be used with --tree or -o %s", OutputFormatFolded)
+	}
+
+	return nil
+}
+
+func (o *startupCommandOperations) runForPod(ctx context.Context, podName string) error {
+	client, err := o.actuatorClientFactory.NewClient(ctx, podName)
+	if err != nil {
+		return err
+	}
+
+	startup, err := client.GetStartup()
+	if err != nil {
+		return err
+	}
+
+	steps := buildStartupSteps(startup.Timeline.Events)
+	if len(steps) == 0 {
+		fmt.Println("No startup steps recorded")
+		return nil
+	}
+
+	switch {
+	case o.output == OutputFormatFolded:
+		displayFoldedStartupSteps(steps, o.minDuration)
+	case o.tree:
+		displayStartupTree(steps, o.minDuration)
+	default:
+		o.displayTopStartupSteps(steps)
+	}
+	return nil
+}
+
+type startupStep struct {
+	id       int64
+	parentID *int64
+	name     string
+	tags     []actuator.StartupStepTag
+	duration time.Duration
+	self     time.Duration
+	parent   *startupStep
+	children []*startupStep
+}
+
+func (s *startupStep) label() string {
+	if len(s.tags) == 0 {
+		return s.name
+	}
+	return s.name + " " + formatStartupTags(s.tags)
+}
+
+// buildStartupSteps links the recorded steps to their parents and returns all steps in start order.
+// Steps whose parent was not recorded (e.g. because the buffer was full) are treated as root steps.
+func buildStartupSteps(events []actuator.StartupEvent) []*startupStep {
+	steps := make([]*startupStep, 0, len(events))
+	byID := make(map[int64]*startupStep, len(events))
+	for _, event := range events {
+		step := &startupStep{
+			id:       event.StartupStep.ID,
+			parentID: event.StartupStep.ParentID,
+			name:     event.StartupStep.Name,
+			tags:     event.StartupStep.Tags,
+			duration: event.Duration.Duration,
+		}
+		steps = append(steps, step)
+		byID[step.id] = step
+	}
+
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].id < steps[j].id })
+
+	for _, step := range steps {
+		if step.parentID == nil {
+			continue
+		}
+		if parent, ok := byID[*step.parentID]; ok && parent != step {
+			step.parent = parent
+			parent.children = append(parent.children, step)
+		}
+	}
+
+	for _, step := range steps {
+		step.self = step.duration
+		for _, child := range step.children {
+			step.self -= child.duration
+		}
+		// Children running on other threads can overlap, which must not lead to negative self times
+		step.self = max(step.self, 0)
+	}
+
+	return steps
+}
+
+func (o *startupCommandOperations) displayTopStartupSteps(steps []*startupStep) {
+	ranked := slices.Clone(steps)
+	rank := func(s *startupStep) time.Duration {
+		if o.sortBy == sortBySelf {
+			return s.self
+		}
+		return s.duration
+	}
+	sort.SliceStable(ranked, func(i, j int) bool { return rank(ranked[i]) > rank(ranked[j]) })
+
+	wideMode := o.output == OutputFormatWide
+
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	if wideMode {
+		_, _ = fmt.Fprintln(w, "ID\tPARENT\tDURATION\tSELF\tSTEP\tTAGS")
+	} else {
+		_, _ = fmt.Fprintln(w, "DURATION\tSELF\tSTEP\tTAGS")
+	}
+
+	shown := 0
+	for _, step := range ranked {
+		if shown == o.top || rank(step) < o.minDuration {
+			break
+		}
+		shown++
+
+		tags := valueOrDash(formatStartupTags(step.tags))
+		if wideMode {
+			parent := "-"
+			if step.parentID != nil {
+				parent = fmt.Sprintf("%d", *step.parentID)
+			}
+			_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n",
+				step.id, parent, formatDurationPrecise(step.duration), formatDurationPrecise(step.self), step.name, tags)
+		} else {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+				formatDurationPrecise(step.duration), formatDurationPrecise(step.self), step.name,
+				truncateString(tags, maxStartupTagsLength))
+		}
+	}
+}
+
+func displayStartupTree(steps []*startupStep, minDuration time.Duration) {
+	w := newTableWriter()
+	defer func() { _ = w.Flush() }()
+
+	_, _ = fmt.Fprintln(w, "DURATION\tSELF\tSTEP")
+
+	var walk func(step *startupStep, depth int)
+	walk = func(step *startupStep, depth int) {
+		if step.duration < minDuration {
+			return
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s%s\n",
+			formatDurationPrecise(step.duration), formatDurationPrecise(step.self), strings.Repeat("  ", depth), step.label())
+		for _, child := range step.children {
+			walk(child, depth+1)
+		}
+	}
+
+	for _, step := range steps {
+		if step.parent == nil {
+			walk(step, 0)
+		}
+	}
+}
+
+// displayFoldedStartupSteps prints one line per step in the folded stack format used by
+// flame graph tools: the semicolon-separated path from the root step followed by the self time.
+func displayFoldedStartupSteps(steps []*startupStep, minDuration time.Duration) {
+	for _, step := range steps {
+		self := step.self.Microseconds()
+		if self <= 0 || step.self < minDuration {
+			continue
+		}
+
+		var frames []string
+		for s := step; s != nil; s = s.parent {
+			frames = append(frames, strings.ReplaceAll(s.label(), ";", ":"))
+		}
+		slices.Reverse(frames)
+
+		fmt.Printf("%s %d\n", strings.Join(frames, ";"), self)
+	}
+}
+
+func formatStartupTags(tags []actuator.StartupStepTag) string {
+	formatted := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		formatted = append(formatted, tag.Key+"="+tag.Value)
+	}
+	return strings.Join(formatted, ",")
+}
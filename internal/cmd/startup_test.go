@@ -0,0 +1,322 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func testStartupEvent(id int64, parentID *int64, name string, duration time.Duration, tags ...actuator.StartupStepTag) actuator.StartupEvent {
+	return actuator.StartupEvent{
+		Duration: actuator.Duration{Duration: duration},
+		StartupStep: actuator.StartupStep{
+			Name:     name,
+			ID:       id,
+			ParentID: parentID,
+			Tags:     tags,
+		},
+	}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+// testStartupEvents describes the following steps:
+//
+//	0 spring.context.refresh (1s)
+//	├── 1 spring.beans.instantiate beanName=orderService (600ms)
+//	│   └── 3 spring.beans.instantiate beanName=orderRepository (400ms)
+//	└── 2 spring.beans.instantiate beanName=userService (100ms)
+//	4 spring.boot.application.ready (5ms), parent 99 was not recorded
+func testStartupEvents() []actuator.StartupEvent {
+	return []actuator.StartupEvent{
+		testStartupEvent(3, int64Ptr(1), "spring.beans.instantiate", 400*time.Millisecond, actuator.StartupStepTag{Key: "beanName", Value: "orderRepository"}),
+		testStartupEvent(1, int64Ptr(0), "spring.beans.instantiate", 600*time.Millisecond, actuator.StartupStepTag{Key: "beanName", Value: "orderService"}),
+		testStartupEvent(2, int64Ptr(0), "spring.beans.instantiate", 100*time.Millisecond, actuator.StartupStepTag{Key: "beanName", Value: "userService"}),
+		testStartupEvent(0, nil, "spring.context.refresh", time.Second),
+		testStartupEvent(4, int64Ptr(99), "spring.boot.application.ready", 5*time.Millisecond),
+	}
+}
+
+func TestBuildStartupSteps(t *testing.T) {
+	steps := buildStartupSteps(testStartupEvents())
+
+	if len(steps) != 5 {
+		t.Fatalf("expected 5 steps, got %d", len(steps))
+	}
+	for i, step := range steps {
+		if step.id != int64(i) {
+			t.Errorf("expected steps ordered by id, got id %d at index %d", step.id, i)
+		}
+	}
+
+	wantSelf := map[int64]time.Duration{
+		0: 300 * time.Millisecond,
+		1: 200 * time.Millisecond,
+		2: 100 * time.Millisecond,
+		3: 400 * time.Millisecond,
+		4: 5 * time.Millisecond,
+	}
+	for _, step := range steps {
+		if step.self != wantSelf[step.id] {
+			t.Errorf("step %d: self = %v, want %v", step.id, step.self, wantSelf[step.id])
+		}
+	}
+
+	if steps[4].parent != nil {
+		t.Error("expected step with unknown parent to be a root step")
+	}
+	if len(steps[0].children) != 2 {
+		t.Errorf("expected 2 children of the root step, got %d", len(steps[0].children))
+	}
+}
+
+func TestBuildStartupStepsClampsOverlappingChildren(t *testing.T) {
+	steps := buildStartupSteps([]actuator.StartupEvent{
+		testStartupEvent(0, nil, "parent", 100*time.Millisecond),
+		testStartupEvent(1, int64Ptr(0), "child", 80*time.Millisecond),
+		testStartupEvent(2, int64Ptr(0), "child", 80*time.Millisecond),
+	})
+
+	if steps[0].self != 0 {
+		t.Errorf("expected self time to be clamped to 0, got %v", steps[0].self)
+	}
+}
+
+func TestStartupValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		pods        []string
+		output      string
+		top         int
+		topSet      bool
+		tree        bool
+		sortBy      string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "defaults",
+			pods:    []string{"pod-1"},
+			top:     defaultStartupTop,
+			sortBy:  sortByDuration,
+			wantErr: false,
+		},
+		{
+			name:    "tree",
+			pods:    []string{"pod-1"},
+			top:     defaultStartupTop,
+			tree:    true,
+			sortBy:  sortByDuration,
+			wantErr: false,
+		},
+		{
+			name:    "folded output",
+			pods:    []string{"pod-1"},
+			output:  "folded",
+			top:     defaultStartupTop,
+			sortBy:  sortByDuration,
+			wantErr: false,
+		},
+		{
+			name:        "top must be positive",
+			pods:        []string{"pod-1"},
+			top:         0,
+			topSet:      true,
+			sortBy:      sortByDuration,
+			wantErr:     true,
+			errContains: "--top must be at least 1",
+		},
+		{
+			name:        "invalid sort field",
+			pods:        []string{"pod-1"},
+			top:         defaultStartupTop,
+			sortBy:      "name",
+			wantErr:     true,
+			errContains: "invalid sort field 'name'",
+		},
+		{
+			name:        "tree with folded output",
+			pods:        []string{"pod-1"},
+			output:      "folded",
+			top:         defaultStartupTop,
+			tree:        true,
+			sortBy:      sortByDuration,
+			wantErr:     true,
+			errContains: "--tree cannot be used with -o folded",
+		},
+		{
+			name:        "top with tree",
+			pods:        []string{"pod-1"},
+			top:         5,
+			topSet:      true,
+			tree:        true,
+			sortBy:      sortByDuration,
+			wantErr:     true,
+			errContains: "--top cannot be used with --tree",
+		},
+		{
+			name:        "invalid output format",
+			pods:        []string{"pod-1"},
+			output:      "json",
+			top:         defaultStartupTop,
+			sortBy:      sortByDuration,
+			wantErr:     true,
+			errContains: "not recognized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &startupCommandOperations{
+				baseOperations: baseOperations{pods: tt.pods},
+				output:         tt.output,
+				top:            tt.top,
+				topSet:         tt.topSet,
+				tree:           tt.tree,
+				sortBy:         tt.sortBy,
+			}
+
+			err := ops.validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("validate() error = %v, want error containing %q", err, tt.errContains)
+			}
+		})
+	}
+}
+
+func TestDisplayTopStartupSteps(t *testing.T) {
+	tests := []struct {
+		name        string
+		top         int
+		sortBy      string
+		output      string
+		minDuration time.Duration
+		pattern     string
+		notExpected []string
+	}{
+		{
+			name:        "top by duration",
+			top:         2,
+			sortBy:      sortByDuration,
+			pattern:     `(?s)DURATION\s+SELF\s+STEP\s+TAGS\n1\.00s\s+300ms\s+spring\.context\.refresh\s+-\n600ms\s+200ms\s+spring\.beans\.instantiate\s+beanName=orderService\n$`,
+			notExpected: []string{"orderRepository"},
+		},
+		{
+			name:    "top by self time",
+			top:     2,
+			sortBy:  sortBySelf,
+			pattern: `(?s)TAGS\n400ms\s+400ms\s+spring\.beans\.instantiate\s+beanName=orderRepository\n1\.00s\s+300ms\s+spring\.context\.refresh`,
+		},
+		{
+			name:    "wide output shows ids",
+			top:     1,
+			sortBy:  sortByDuration,
+			output:  OutputFormatWide,
+			pattern: `ID\s+PARENT\s+DURATION\s+SELF\s+STEP\s+TAGS\n0\s+-\s+1\.00s`,
+		},
+		{
+			name:        "min duration",
+			top:         defaultStartupTop,
+			sortBy:      sortByDuration,
+			minDuration: 500 * time.Millisecond,
+			notExpected: []string{"userService", "orderRepository", "application.ready"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &startupCommandOperations{top: tt.top, sortBy: tt.sortBy, output: tt.output, minDuration: tt.minDuration}
+
+			output := captureOutput(func() {
+				ops.displayTopStartupSteps(buildStartupSteps(testStartupEvents()))
+			})
+
+			if tt.pattern != "" && !regexp.MustCompile(tt.pattern).MatchString(output) {
+				t.Errorf("displayTopStartupSteps() output does not match %q:\n%s", tt.pattern, output)
+			}
+			for _, notExpected := range tt.notExpected {
+				if strings.Contains(output, notExpected) {
+					t.Errorf("displayTopStartupSteps() output contains unexpected value:\n  unwanted: %s\n  got:\n%s", notExpected, output)
+				}
+			}
+		})
+	}
+}
+
+func TestDisplayStartupTree(t *testing.T) {
+	output := captureOutput(func() {
+		displayStartupTree(buildStartupSteps(testStartupEvents()), 0)
+	})
+
+	expectedLines := []string{
+		"spring.context.refresh",
+		"  spring.beans.instantiate beanName=orderService",
+		"    spring.beans.instantiate beanName=orderRepository",
+		"  spring.beans.instantiate beanName=userService",
+		"spring.boot.application.ready",
+	}
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != len(expectedLines)+1 {
+		t.Fatalf("expected %d lines, got:\n%s", len(expectedLines)+1, output)
+	}
+	for i, expected := range expectedLines {
+		if !strings.HasSuffix(lines[i+1], "  "+expected) {
+			t.Errorf("line %d = %q, want suffix %q", i+1, lines[i+1], expected)
+		}
+	}
+}
+
+func TestDisplayStartupTreeMinDurationPrunesSubtrees(t *testing.T) {
+	output := captureOutput(func() {
+		displayStartupTree(buildStartupSteps(testStartupEvents()), 500*time.Millisecond)
+	})
+
+	if !strings.Contains(output, "beanName=orderService") {
+		t.Errorf("expected orderService in output:\n%s", output)
+	}
+	for _, unexpected := range []string{"orderRepository", "userService", "application.ready"} {
+		if strings.Contains(output, unexpected) {
+			t.Errorf("unexpected %s in output:\n%s", unexpected, output)
+		}
+	}
+}
+
+func TestDisplayFoldedStartupSteps(t *testing.T) {
+	output := captureOutput(func() {
+		displayFoldedStartupSteps(buildStartupSteps(testStartupEvents()), 0)
+	})
+
+	expected := strings.Join([]string{
+		"spring.context.refresh 300000",
+		"spring.context.refresh;spring.beans.instantiate beanName=orderService 200000",
+		"spring.context.refresh;spring.beans.instantiate beanName=userService 100000",
+		"spring.context.refresh;spring.beans.instantiate beanName=orderService;spring.beans.instantiate beanName=orderRepository 400000",
+		"spring.boot.application.ready 5000",
+	}, "\n") + "\n"
+
+	if output != expected {
+		t.Errorf("displayFoldedStartupSteps() =\n%s\nwant:\n%s", output, expected)
+	}
+}
+
+func TestDisplayFoldedStartupStepsEscapesSeparators(t *testing.T) {
+	output := captureOutput(func() {
+		displayFoldedStartupSteps(buildStartupSteps([]actuator.StartupEvent{
+			testStartupEvent(0, nil, "step;with;semicolons", time.Millisecond),
+		}), 0)
+	})
+
+	if output != "step:with:semicolons 1000\n" {
+		t.Errorf("displayFoldedStartupSteps() = %q", output)
+	}
+}
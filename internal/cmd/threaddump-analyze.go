@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+const (
+	// defaultMaxContendedLocks limits the lock contention table unless -o wide is used
+	defaultMaxContendedLocks = 10
+	deadlockStackFrames      = 3
+)
+
+// lockAnalysis is derived from the wait-for graph of a thread dump, in which every thread that waits
+// for a lock points to the thread owning that lock
+type lockAnalysis struct {
+	// deadlocks holds the threads of each wait cycle, every thread waiting for the next one
+	deadlocks  [][]actuator.Thread
+	contention []lockContention
+}
+
+type lockContention struct {
+	lock    string
+	owner   actuator.Thread
+	waiters []actuator.Thread
+	// lockedAt is the stack frame in which the owner entered the monitor; nil for synchronizers like ReentrantLock
+	lockedAt *actuator.StackFrame
+}
+
+func analyzeLocks(threads []actuator.Thread) *lockAnalysis {
+	byID := make(map[int64]actuator.Thread, len(threads))
+	for _, thread := range threads {
+		byID[thread.ThreadID] = thread
+	}
+
+	owner := func(thread actuator.Thread) (actuator.Thread, bool) {
+		if thread.LockOwnerId <= 0 {
+			return actuator.Thread{}, false
+		}
+		lockOwner, ok := byID[thread.LockOwnerId]
+		return lockOwner, ok
+	}
+
+	analysis := &lockAnalysis{}
+
+	// Every thread waits for at most one lock, so a cycle is found by following the owners until a thread
+	// is seen again that is part of the current path
+	const (
+		unvisited = iota
+		onPath
+		done
+	)
+	visited := make(map[int64]int, len(threads))
+	for _, thread := range threads {
+		var path []actuator.Thread
+		position := make(map[int64]int)
+		current, ok := thread, true
+		for ok && visited[current.ThreadID] == unvisited {
+			visited[current.ThreadID] = onPath
+			position[current.ThreadID] = len(path)
+			path = append(path, current)
+			current, ok = owner(current)
+		}
+		if ok && visited[current.ThreadID] == onPath {
+			analysis.deadlocks = append(analysis.deadlocks, path[position[current.ThreadID]:])
+		}
+		for _, t := range path {
+			visited[t.ThreadID] = done
+		}
+	}
+
+	contended := make(map[string]*lockContention)
+	var keys []string
+	for _, thread := range threads {
+		lockOwner, ok := owner(thread)
+		if !ok {
+			continue
+		}
+		lock := lockName(thread)
+		key := fmt.Sprintf("%d/%s", lockOwner.ThreadID, lock)
+		if contended[key] == nil {
+			contended[key] = &lockContention{lock: lock, owner: lockOwner, lockedAt: lockedStackFrame(lockOwner, lock)}
+			keys = append(keys, key)
+		}
+		contended[key].waiters = append(contended[key].waiters, thread)
+	}
+	for _, key := range keys {
+		analysis.contention = append(analysis.contention, *contended[key])
+	}
+	sort.SliceStable(analysis.contention, func(i, j int) bool {
+		return len(analysis.contention[i].waiters) > len(analysis.contention[j].waiters)
+	})
+
+	return analysis
+}
+
+// lockName returns the lock a thread waits for as class name and identity hash, e.g. java.lang.Object@1b2c3d4e
+func lockName(thread actuator.Thread) string {
+	if thread.LockInfo != nil {
+		return thread.LockInfo.String()
+	}
+	if thread.LockName != "" {
+		return thread.LockName
+	}
+	return "unknown lock"
+}
+
+func lockedStackFrame(owner actuator.Thread, lock string) *actuator.StackFrame {
+	for _, monitor := range owner.LockedMonitors {
+		if monitor.String() == lock {
+			return monitor.LockedStackFrame
+		}
+	}
+	return nil
+}
+
+func (o *threaddumpCommandOperations) displayLockAnalysis(analysis *lockAnalysis) {
+	fmt.Printf("Deadlocks: %d\n", len(analysis.deadlocks))
+	for i, cycle := range analysis.deadlocks {
+		fmt.Printf("\nDeadlock #%d (%d threads):\n", i+1, len(cycle))
+		for j, thread := range cycle {
+			next := cycle[(j+1)%len(cycle)]
+			fmt.Printf("  %s is %s on %s owned by %s\n", formatThreadRef(thread), thread.ThreadState, lockName(thread), formatThreadRef(next))
+			if !o.noStacktrace {
+				displayDeadlockFrames(thread.StackTrace, o.wideMode)
+			}
+		}
+	}
+
+	fmt.Println()
+	fmt.Println("Lock Contention:")
+	if len(analysis.contention) == 0 {
+		fmt.Println("  No threads are waiting for locks owned by other threads.")
+		return
+	}
+
+	contention := analysis.contention
+	if !o.wideMode && len(contention) > defaultMaxContendedLocks {
+		contention = contention[:defaultMaxContendedLocks]
+	}
+
+	w := newTableWriter()
+	header := "LOCK\tOWNER\tOWNER STATE\tWAITING\tLOCKED AT"
+	if o.wideMode {
+		header += "\tWAITING THREADS"
+	}
+	_, _ = fmt.Fprintln(w, header)
+	for _, c := range contention {
+		lockedAt := "-"
+		if c.lockedAt != nil {
+			lockedAt = formatFrame(*c.lockedAt)
+		}
+		row := fmt.Sprintf("%s\t%s (ID: %d)\t%s\t%d\t%s", c.lock, c.owner.ThreadName, c.owner.ThreadID, c.owner.ThreadState, len(c.waiters), lockedAt)
+		if o.wideMode {
+			names := make([]string, len(c.waiters))
+			for i, waiter := range c.waiters {
+				names[i] = waiter.ThreadName
+			}
+			row += "\t" + strings.Join(names, ", ")
+		}
+		_, _ = fmt.Fprintln(w, row)
+	}
+	_ = w.Flush()
+
+	if len(analysis.contention) > len(contention) {
+		fmt.Printf("... %d more contended locks (use -o wide to show all)\n", len(analysis.contention)-len(contention))
+	}
+}
+
+func displayDeadlockFrames(frames []actuator.StackFrame, wideMode bool) {
+	framesToShow := len(frames)
+	if !wideMode && framesToShow > deadlockStackFrames {
+		framesToShow = deadlockStackFrames
+	}
+	for _, frame := range frames[:framesToShow] {
+		fmt.Printf("      at %s\n", formatFrame(frame))
+	}
+	if len(frames) > framesToShow {
+		fmt.Printf("      ... %d more frames\n", len(frames)-framesToShow)
+	}
+}
+
+func formatThreadRef(thread actuator.Thread) string {
+	return fmt.Sprintf("%q (ID: %d)", thread.ThreadName, thread.ThreadID)
+}
+
+func formatFrame(frame actuator.StackFrame) string {
+	return fmt.Sprintf("%s.%s(%s)", frame.ClassName, frame.MethodName, formatFrameLocation(frame))
+}
@@ -0,0 +1,234 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+// defaultMaxThreadGrowth limits the table of threads with growing blocked and waited counts unless -o wide is used
+const defaultMaxThreadGrowth = 20
+
+// runCompare takes the first thread dump of all pods, waits once for --compare-after and then takes the
+// second thread dumps, so that all pods are compared over the same period
+func (o *threaddumpCommandOperations) runCompare(ctx context.Context) error {
+	clients := make(map[string]actuator.Client, len(o.pods))
+	var pods []string
+	for _, pod := range o.pods {
+		client, err := o.actuatorClientFactory.NewClient(ctx, pod)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %s: %v\n", pod, err)
+			continue
+		}
+		clients[pod] = client
+		pods = append(pods, pod)
+	}
+
+	failed, err := o.compareWithLaterDumps(ctx, pods, clients)
+	if err != nil {
+		return err
+	}
+	failed += len(o.pods) - len(pods)
+	if failed > 0 {
+		return fmt.Errorf("compare thread dumps failed on %d pod(s)", failed)
+	}
+	return nil
+}
+
+// compareWithLaterDumps shows for each pod how the threads changed between its two thread dumps and returns
+// the number of pods that could not be compared
+func (o *threaddumpCommandOperations) compareWithLaterDumps(ctx context.Context, pods []string, clients map[string]actuator.Client) (int, error) {
+	before := make(map[string]*actuator.ThreadDumpResponse, len(pods))
+	after := make(map[string]*actuator.ThreadDumpResponse, len(pods))
+	errs := make(map[string]error)
+
+	for _, pod := range pods {
+		threadDump, err := clients[pod].GetThreadDump()
+		if err != nil {
+			errs[pod] = err
+			continue
+		}
+		before[pod] = threadDump
+	}
+
+	if len(before) > 0 {
+		_, _ = fmt.Fprintf(os.Stderr, "Taking a second thread dump in %s...\n", o.compareAfter)
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(o.compareAfter):
+		}
+
+		for _, pod := range pods {
+			if before[pod] == nil {
+				continue
+			}
+			threadDump, err := clients[pod].GetThreadDump()
+			if err != nil {
+				errs[pod] = err
+				continue
+			}
+			after[pod] = threadDump
+		}
+	}
+
+	for i, pod := range pods {
+		if len(pods) > 1 {
+			fmt.Printf("%s:\n", pod)
+		}
+		if err := errs[pod]; err != nil {
+			fmt.Printf("Error: %v\n", err)
+		} else {
+			o.displayComparison(before[pod], after[pod])
+		}
+		if i != len(pods)-1 {
+			fmt.Println()
+		}
+	}
+
+	return len(errs), nil
+}
+
+func (o *threaddumpCommandOperations) displayComparison(before, after *actuator.ThreadDumpResponse) {
+	beforeThreads, _ := o.filterThreads(before.Threads)
+	afterThreads, _ := o.filterThreads(after.Threads)
+
+	fmt.Printf("Compared thread dumps taken %s apart: %d threads before, %d after\n",
+		o.compareAfter, len(beforeThreads), len(afterThreads))
+	o.displayThreadDumpDiff(compareThreadDumps(beforeThreads, afterThreads))
+}
+
+type threadDumpDiff struct {
+	// stuck holds the threads that are BLOCKED or WAITING on the same frame in both dumps and did not
+	// block or wait again in between
+	stuck    []actuator.Thread
+	added    []actuator.Thread
+	vanished []actuator.Thread
+	growth   []threadGrowth
+}
+
+type threadGrowth struct {
+	thread  actuator.Thread
+	blocked int64
+	waited  int64
+}
+
+// compareThreadDumps matches the threads of two dumps by their ID, which the JVM does not reuse
+func compareThreadDumps(before, after []actuator.Thread) *threadDumpDiff {
+	diff := &threadDumpDiff{}
+
+	beforeByID := make(map[int64]actuator.Thread, len(before))
+	for _, thread := range before {
+		beforeByID[thread.ThreadID] = thread
+	}
+	afterByID := make(map[int64]bool, len(after))
+
+	for _, thread := range after {
+		afterByID[thread.ThreadID] = true
+		previous, exists := beforeByID[thread.ThreadID]
+		if !exists {
+			diff.added = append(diff.added, thread)
+			continue
+		}
+
+		growth := threadGrowth{
+			thread:  thread,
+			blocked: thread.BlockedCount - previous.BlockedCount,
+			waited:  thread.WaitedCount - previous.WaitedCount,
+		}
+		if growth.blocked > 0 || growth.waited > 0 {
+			diff.growth = append(diff.growth, growth)
+		} else if isWaitingState(previous.ThreadState) && previous.ThreadState == thread.ThreadState &&
+			len(thread.StackTrace) > 0 && topFrame(previous) == topFrame(thread) {
+			diff.stuck = append(diff.stuck, thread)
+		}
+	}
+
+	for _, thread := range before {
+		if !afterByID[thread.ThreadID] {
+			diff.vanished = append(diff.vanished, thread)
+		}
+	}
+
+	sort.SliceStable(diff.growth, func(i, j int) bool {
+		return diff.growth[i].blocked+diff.growth[i].waited > diff.growth[j].blocked+diff.growth[j].waited
+	})
+
+	return diff
+}
+
+func isWaitingState(state string) bool {
+	return state == "BLOCKED" || state == "WAITING" || state == "TIMED_WAITING"
+}
+
+func topFrame(thread actuator.Thread) string {
+	if len(thread.StackTrace) == 0 {
+		return "-"
+	}
+	return formatFrame(thread.StackTrace[0])
+}
+
+func (o *threaddumpCommandOperations) displayThreadDumpDiff(diff *threadDumpDiff) {
+	fmt.Println()
+	fmt.Println("STUCK THREADS")
+	if len(diff.stuck) == 0 {
+		fmt.Println("No threads stayed BLOCKED or WAITING on the same frame.")
+	} else {
+		w := newTableWriter()
+		_, _ = fmt.Fprintln(w, "THREAD\tID\tSTATE\tFRAME\tLOCK")
+		for _, thread := range diff.stuck {
+			lock := "-"
+			if thread.LockInfo != nil || thread.LockName != "" {
+				lock = lockName(thread)
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", thread.ThreadName, thread.ThreadID, thread.ThreadState, topFrame(thread), lock)
+		}
+		_ = w.Flush()
+	}
+
+	fmt.Println()
+	fmt.Println("PROGRESSING THREADS")
+	if len(diff.growth) == 0 {
+		fmt.Println("No thread was blocked or waited in between.")
+	} else {
+		growth := diff.growth
+		if !o.wideMode && len(growth) > defaultMaxThreadGrowth {
+			growth = growth[:defaultMaxThreadGrowth]
+		}
+
+		w := newTableWriter()
+		_, _ = fmt.Fprintln(w, "THREAD\tID\tSTATE\tBLOCKED\tWAITED")
+		for _, g := range growth {
+			_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t+%d (%d)\t+%d (%d)\n", g.thread.ThreadName, g.thread.ThreadID, g.thread.ThreadState,
+				g.blocked, g.thread.BlockedCount, g.waited, g.thread.WaitedCount)
+		}
+		_ = w.Flush()
+
+		if len(diff.growth) > len(growth) {
+			fmt.Printf("... %d more threads (use -o wide to show all)\n", len(diff.growth)-len(growth))
+		}
+	}
+
+	displayThreadChanges("NEW THREADS", diff.added)
+	displayThreadChanges("VANISHED THREADS", diff.vanished)
+}
+
+func displayThreadChanges(title string, threads []actuator.Thread) {
+	if len(threads) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(title)
+	w := newTableWriter()
+	_, _ = fmt.Fprintln(w, "THREAD\tID\tSTATE")
+	for _, thread := range threads {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\n", thread.ThreadName, thread.ThreadID, thread.ThreadState)
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+// maxGroupThreadNames limits the thread names listed per group unless -o wide is used
+const maxGroupThreadNames = 5
+
+type threadGroup struct {
+	state string
+	// stackTrace holds the compared frames, i.e. the top frames if a depth is set
+	stackTrace []actuator.StackFrame
+	threads    []actuator.Thread
+}
+
+// groupThreads groups threads by state and stack trace, comparing only the top depth frames if depth is
+// positive. The groups are ordered by size, largest first.
+func groupThreads(threads []actuator.Thread, depth int) []*threadGroup {
+	var groups []*threadGroup
+	byKey := make(map[string]*threadGroup)
+
+	for _, thread := range threads {
+		frames := thread.StackTrace
+		if depth > 0 && len(frames) > depth {
+			frames = frames[:depth]
+		}
+
+		key := threadGroupKey(thread.ThreadState, frames)
+		group, exists := byKey[key]
+		if !exists {
+			group = &threadGroup{state: thread.ThreadState, stackTrace: frames}
+			byKey[key] = group
+			groups = append(groups, group)
+		}
+		group.threads = append(group.threads, thread)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return len(groups[i].threads) > len(groups[j].threads)
+	})
+	return groups
+}
+
+func threadGroupKey(state string, frames []actuator.StackFrame) string {
+	parts := make([]string, 0, len(frames)+1)
+	parts = append(parts, state)
+	for _, frame := range frames {
+		parts = append(parts, formatFrame(frame))
+	}
+	return strings.Join(parts, "\n")
+}
+
+func displayThreadGroup(group *threadGroup, index int, wideMode, noStacktrace bool, maxFrames int) {
+	fmt.Printf("Group #%d: %d thread(s)\n", index, len(group.threads))
+	fmt.Printf("  State: %s\n", group.state)
+
+	names := make([]string, 0, len(group.threads))
+	for _, thread := range group.threads {
+		names = append(names, thread.ThreadName)
+	}
+	if !wideMode && len(names) > maxGroupThreadNames {
+		more := len(names) - maxGroupThreadNames
+		names = append(names[:maxGroupThreadNames], fmt.Sprintf("... %d more", more))
+	}
+	fmt.Printf("  Threads: %s\n", strings.Join(names, ", "))
+
+	if !noStacktrace && len(group.stackTrace) > 0 {
+		displayStackTrace(group.stackTrace, maxFrames)
+	}
+
+	fmt.Println()
+}
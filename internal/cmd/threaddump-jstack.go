@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+const jstackTimestampFormat = "2006-01-02 15:04:05"
+
+// writeJstack prints the thread dump in the jstack format, or saves it to a file in the --save directory
+func (o *threaddumpCommandOperations) writeJstack(podName string, threaddump *actuator.ThreadDumpResponse, now time.Time) error {
+	threads, _ := o.filterThreads(threaddump.Threads)
+
+	if o.saveDir == "" {
+		formatJstack(os.Stdout, threads, threaddump.Threads, now)
+		return nil
+	}
+
+	if err := os.MkdirAll(o.saveDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	var dump strings.Builder
+	formatJstack(&dump, threads, threaddump.Threads, now)
+
+	path := filepath.Join(o.saveDir, fmt.Sprintf("%s-%s.tdump", podName, now.Format(heapDumpTimestampFormat)))
+	if _, err := saveStream(strings.NewReader(dump.String()), path, io.Discard); err != nil {
+		return err
+	}
+
+	fmt.Printf("Thread dump saved to %s (%d threads)\n", path, len(threads))
+	return nil
+}
+
+// formatJstack writes threads in the layout of the HotSpot jstack tool. The deadlocks are detected on all
+// threads of the dump, so that they are reported even if the listed threads are filtered.
+func formatJstack(w io.Writer, threads []actuator.Thread, all []actuator.Thread, now time.Time) {
+	_, _ = fmt.Fprintln(w, now.Format(jstackTimestampFormat))
+	_, _ = fmt.Fprintln(w, "Full thread dump:")
+	_, _ = fmt.Fprintln(w)
+
+	for _, thread := range threads {
+		formatJstackThread(w, thread)
+	}
+
+	deadlocks := analyzeLocks(all).deadlocks
+	for _, cycle := range deadlocks {
+		formatJstackDeadlock(w, cycle)
+	}
+	switch {
+	case len(deadlocks) == 1:
+		_, _ = fmt.Fprintln(w, "Found 1 deadlock.")
+	case len(deadlocks) > 1:
+		_, _ = fmt.Fprintf(w, "Found %d deadlocks.\n", len(deadlocks))
+	}
+}
+
+func formatJstackThread(w io.Writer, thread actuator.Thread) {
+	daemon := ""
+	if thread.Daemon {
+		daemon = " daemon"
+	}
+	status, detail := jstackStatus(thread)
+
+	// The actuator does not expose the native thread, so the Java thread ID is used for tid and nid
+	_, _ = fmt.Fprintf(w, "\"%s\" #%d%s prio=%d tid=0x%016x nid=0x%x %s\n",
+		thread.ThreadName, thread.ThreadID, daemon, thread.Priority, thread.ThreadID, thread.ThreadID, status)
+	_, _ = fmt.Fprintf(w, "   java.lang.Thread.State: %s%s\n", thread.ThreadState, detail)
+
+	formatJstackFrames(w, thread)
+
+	_, _ = fmt.Fprintln(w)
+	_, _ = fmt.Fprintln(w, "   Locked ownable synchronizers:")
+	if len(thread.LockedSynchronizers) == 0 {
+		_, _ = fmt.Fprintln(w, "\t- None")
+	}
+	for _, synchronizer := range thread.LockedSynchronizers {
+		_, _ = fmt.Fprintf(w, "\t- %s\n", formatJstackLock(synchronizer))
+	}
+	_, _ = fmt.Fprintln(w)
+}
+
+func formatJstackFrames(w io.Writer, thread actuator.Thread) {
+	for i, frame := range thread.StackTrace {
+		_, _ = fmt.Fprintf(w, "\tat %s\n", formatFrame(frame))
+
+		if i == 0 && thread.LockInfo != nil {
+			_, _ = fmt.Fprintf(w, "\t- %s %s\n", jstackWaitAction(thread), formatJstackLock(*thread.LockInfo))
+		}
+		for _, monitor := range thread.LockedMonitors {
+			if monitor.LockedStackDepth == i {
+				_, _ = fmt.Fprintf(w, "\t- locked %s\n", formatJstackLock(monitor.LockInfo))
+			}
+		}
+	}
+}
+
+// jstackStatus returns the status of the thread header and the detail of the thread state, e.g.
+// "waiting on condition" and " (parking)"
+func jstackStatus(thread actuator.Thread) (string, string) {
+	switch thread.ThreadState {
+	case "RUNNABLE":
+		return "runnable", ""
+	case "BLOCKED":
+		return "waiting for monitor entry", " (on object monitor)"
+	case "WAITING", "TIMED_WAITING":
+		switch topMethod(thread) {
+		case "java.lang.Object.wait", "java.lang.Object.wait0":
+			return "in Object.wait()", " (on object monitor)"
+		case "java.lang.Thread.sleep", "java.lang.Thread.sleep0":
+			return "waiting on condition", " (sleeping)"
+		}
+		return "waiting on condition", " (parking)"
+	}
+	return strings.ToLower(thread.ThreadState), ""
+}
+
+func jstackWaitAction(thread actuator.Thread) string {
+	status, _ := jstackStatus(thread)
+	switch {
+	case thread.ThreadState == "BLOCKED":
+		return "waiting to lock"
+	case status == "in Object.wait()":
+		return "waiting on"
+	}
+	// jstack aligns the lock with the lines above
+	return "parking to wait for "
+}
+
+func topMethod(thread actuator.Thread) string {
+	if len(thread.StackTrace) == 0 {
+		return ""
+	}
+	return thread.StackTrace[0].ClassName + "." + thread.StackTrace[0].MethodName
+}
+
+// formatJstackLock formats a lock like jstack, with the identity hash in place of the address
+func formatJstackLock(lock actuator.LockInfo) string {
+	return fmt.Sprintf("<0x%016x> (a %s)", lock.IdentityHashCode, lock.ClassName)
+}
+
+func formatJstackDeadlock(w io.Writer, cycle []actuator.Thread) {
+	_, _ = fmt.Fprintln(w, "Found one Java-level deadlock:")
+	_, _ = fmt.Fprintln(w, "=============================")
+	for i, thread := range cycle {
+		owner := cycle[(i+1)%len(cycle)]
+		_, _ = fmt.Fprintf(w, "\"%s\":\n", thread.ThreadName)
+		if thread.LockInfo == nil {
+			_, _ = fmt.Fprintf(w, "  waiting for %s,\n", lockName(thread))
+		} else if thread.ThreadState == "BLOCKED" {
+			_, _ = fmt.Fprintf(w, "  waiting to lock monitor 0x%016x (object 0x%016x, a %s),\n",
+				thread.LockInfo.IdentityHashCode, thread.LockInfo.IdentityHashCode, thread.LockInfo.ClassName)
+		} else {
+			_, _ = fmt.Fprintf(w, "  waiting for ownable synchronizer 0x%016x, (a %s),\n",
+				thread.LockInfo.IdentityHashCode, thread.LockInfo.ClassName)
+		}
+		_, _ = fmt.Fprintf(w, "  which is held by \"%s\"\n", owner.ThreadName)
+	}
+	_, _ = fmt.Fprintln(w)
+
+	_, _ = fmt.Fprintln(w, "Java stack information for the threads listed above:")
+	_, _ = fmt.Fprintln(w, "===================================================")
+	for _, thread := range cycle {
+		_, _ = fmt.Fprintf(w, "\"%s\":\n", thread.ThreadName)
+		formatJstackFrames(w, thread)
+	}
+	_, _ = fmt.Fprintln(w)
+}
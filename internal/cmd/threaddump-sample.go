@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+const (
+	defaultSampleInterval  = 500 * time.Millisecond
+	defaultSampleOutputDir = "."
+	// defaultMaxHotMethods limits the hot methods table unless -o wide is used
+	defaultMaxHotMethods = 20
+
+	// threadDumpEndpointClass is on the stack of the thread serving the thread dump request, which would
+	// otherwise show up in every sample
+	threadDumpEndpointClass = "org.springframework.boot.actuate.management.ThreadDumpEndpoint"
+)
+
+// runSample takes thread dumps from all pods in the same rounds and writes the sampled stacks of each pod
+// once all samples are taken. If the context is cancelled, the samples taken so far are written.
+func (o *threaddumpCommandOperations) runSample(ctx context.Context) error {
+	clients := make(map[string]actuator.Client, len(o.pods))
+	var pods []string
+	for _, pod := range o.pods {
+		client, err := o.actuatorClientFactory.NewClient(ctx, pod)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %s: %v\n", pod, err)
+			continue
+		}
+		clients[pod] = client
+		pods = append(pods, pod)
+	}
+	if len(clients) == 0 {
+		return fmt.Errorf("sample threads failed on %d pod(s)", len(o.pods))
+	}
+
+	if err := os.MkdirAll(o.outputDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	profiles := make(map[string]*stackProfile, len(pods))
+	for _, pod := range pods {
+		profiles[pod] = newStackProfile()
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "Taking %d thread dumps every %s from %d pod(s)...\n", o.sample, o.interval, len(pods))
+
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+sampling:
+	for i := 0; i < o.sample; i++ {
+		for _, pod := range pods {
+			threadDump, err := clients[pod].GetThreadDump()
+			if err != nil {
+				profiles[pod].err = err
+				profiles[pod].failed++
+				continue
+			}
+			profiles[pod].add(threadDump.Threads, o.nameFilter)
+		}
+
+		if i == o.sample-1 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			_, _ = fmt.Fprintln(os.Stderr, "Sampling interrupted, writing the samples taken so far")
+			break sampling
+		case <-ticker.C:
+		}
+	}
+
+	failed := len(o.pods) - len(pods)
+	timestamp := time.Now()
+	for i, pod := range pods {
+		if len(pods) > 1 {
+			fmt.Printf("%s:\n", pod)
+		}
+		if err := o.writeProfile(pod, profiles[pod], timestamp); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			failed++
+		}
+		if i != len(pods)-1 {
+			fmt.Println()
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("sample threads failed on %d pod(s)", failed)
+	}
+	return nil
+}
+
+func (o *threaddumpCommandOperations) writeProfile(pod string, profile *stackProfile, timestamp time.Time) error {
+	if profile.samples == 0 {
+		return fmt.Errorf("no thread dump could be taken: %w", profile.err)
+	}
+
+	path := filepath.Join(o.outputDir, foldedStacksFileName(pod, timestamp))
+	var folded strings.Builder
+	profile.writeFolded(&folded)
+	if _, err := saveStream(strings.NewReader(folded.String()), path, io.Discard); err != nil {
+		return err
+	}
+
+	fmt.Printf("Samples: %d, RUNNABLE stacks: %d\n", profile.samples, profile.stackSamples)
+	if profile.failed > 0 {
+		fmt.Printf("Failed thread dumps: %d (last error: %v)\n", profile.failed, profile.err)
+	}
+	fmt.Printf("Folded stacks saved to %s\n", path)
+
+	if profile.stackSamples == 0 {
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Println("HOT METHODS")
+	profile.displayHotMethods(o.wideMode)
+	return nil
+}
+
+func foldedStacksFileName(podName string, t time.Time) string {
+	return fmt.Sprintf("%s-%s.folded", podName, t.Format(heapDumpTimestampFormat))
+}
+
+// stackProfile counts the RUNNABLE stacks of all thread dumps taken from a pod
+type stackProfile struct {
+	samples      int
+	failed       int
+	err          error
+	stackSamples int
+	// stacks counts each stack in folded format, i.e. the frames from the root to the top joined by ";"
+	stacks map[string]int
+	// self counts how often a method was the top frame, total how often it was anywhere on the stack
+	self  map[string]int
+	total map[string]int
+}
+
+func newStackProfile() *stackProfile {
+	return &stackProfile{
+		stacks: make(map[string]int),
+		self:   make(map[string]int),
+		total:  make(map[string]int),
+	}
+}
+
+func (p *stackProfile) add(threads []actuator.Thread, nameFilter string) {
+	p.samples++
+	for _, thread := range threads {
+		if thread.ThreadState != "RUNNABLE" || len(thread.StackTrace) == 0 || isThreadDumpRequest(thread) {
+			continue
+		}
+		if nameFilter != "" && !strings.Contains(strings.ToLower(thread.ThreadName), strings.ToLower(nameFilter)) {
+			continue
+		}
+
+		frames := make([]string, len(thread.StackTrace))
+		seen := make(map[string]bool, len(frames))
+		for i, frame := range thread.StackTrace {
+			method := frame.ClassName + "." + frame.MethodName
+			frames[len(frames)-1-i] = method
+			// Recursive methods are counted once per stack
+			if !seen[method] {
+				seen[method] = true
+				p.total[method]++
+			}
+		}
+
+		p.stackSamples++
+		p.self[frames[len(frames)-1]]++
+		p.stacks[strings.Join(frames, ";")]++
+	}
+}
+
+func isThreadDumpRequest(thread actuator.Thread) bool {
+	for _, frame := range thread.StackTrace {
+		if frame.ClassName == threadDumpEndpointClass {
+			return true
+		}
+	}
+	return false
+}
+
+// writeFolded writes one line per stack, ordered by the number of samples
+func (p *stackProfile) writeFolded(w io.Writer) {
+	stacks := make([]string, 0, len(p.stacks))
+	for stack := range p.stacks {
+		stacks = append(stacks, stack)
+	}
+	sort.Slice(stacks, func(i, j int) bool {
+		if p.stacks[stacks[i]] != p.stacks[stacks[j]] {
+			return p.stacks[stacks[i]] > p.stacks[stacks[j]]
+		}
+		return stacks[i] < stacks[j]
+	})
+
+	for _, stack := range stacks {
+		_, _ = fmt.Fprintf(w, "%s %d\n", stack, p.stacks[stack])
+	}
+}
+
+// displayHotMethods lists the methods by the share of samples in which they were the top frame (SELF)
+// or anywhere on the stack (TOTAL)
+func (p *stackProfile) displayHotMethods(wideMode bool) {
+	methods := make([]string, 0, len(p.total))
+	for method := range p.total {
+		methods = append(methods, method)
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		a, b := methods[i], methods[j]
+		if p.self[a] != p.self[b] {
+			return p.self[a] > p.self[b]
+		}
+		if p.total[a] != p.total[b] {
+			return p.total[a] > p.total[b]
+		}
+		return a < b
+	})
+
+	shown := methods
+	if !wideMode && len(shown) > defaultMaxHotMethods {
+		shown = shown[:defaultMaxHotMethods]
+	}
+
+	w := newTableWriter()
+	_, _ = fmt.Fprintln(w, "METHOD\tSELF\tSELF%\tTOTAL\tTOTAL%")
+	for _, method := range shown {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%.1f%%\t%d\t%.1f%%\n", method,
+			p.self[method], p.percentage(p.self[method]),
+			p.total[method], p.percentage(p.total[method]))
+	}
+	_ = w.Flush()
+
+	if len(methods) > len(shown) {
+		fmt.Printf("... %d more methods (use -o wide to show all)\n", len(methods)-len(shown))
+	}
+}
+
+func (p *stackProfile) percentage(count int) float64 {
+	return float64(count) / float64(p.stackSamples) * 100
+}
@@ -3,8 +3,10 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
 	"github.com/spf13/cobra"
@@ -22,6 +24,14 @@ type threaddumpCommandOperations struct {
 	nameFilter   string
 	summary      bool
 	noStacktrace bool
+	analyze      bool
+	group        bool
+	depth        int
+	sample       int
+	interval     time.Duration
+	outputDir    string
+	saveDir      string
+	compareAfter time.Duration
 	wideMode     bool
 }
 
@@ -38,7 +48,36 @@ func NewThreadDumpCommand(configFlags *genericclioptions.ConfigFlags, podResolve
 		Short: "Get thread dump and analyze thread states",
 		Long: `Get thread dump from Spring Boot Actuator.
 
-Displays thread information including thread states, blocked threads, and stack traces.`,
+Displays thread information including thread states, blocked threads, and stack traces.
+
+With --analyze, the threads are not listed. Instead, the threads waiting for a lock
+are linked to the thread owning it to find deadlocks, and the most contended locks
+are listed with their owner and the number of waiting threads. Locks are shown as
+class name and identity hash, e.g. java.lang.Object@1b2c3d4e.
+
+With --group, threads with the same state and stack trace are shown as one group
+with the number and names of its threads, largest groups first. Use --depth to
+compare only the top N frames of each stack.
+
+With --sample, the given number of thread dumps is taken from all selected pods,
+one every --interval. The stacks of the RUNNABLE threads are written to one file
+per pod in the folded format (frame;frame;frame count) that flame graph tools
+like flamegraph.pl or speedscope read, and the methods found most often are
+shown in a table. This works as a simple sampling profiler where no profiler
+can be attached to the JVM.
+
+With -o jstack, the thread dump is printed in the text format of the HotSpot jstack
+tool, including the locked monitors and a section for each deadlock, which thread
+dump analyzers like fastThread, TDA or IntelliJ IDEA can read. Add --save to write
+the dump of each pod to <pod>-<timestamp>.tdump in the given directory instead.
+
+With --compare-after, a second thread dump is taken after the given duration and
+compared to the first one. Threads that stayed BLOCKED or WAITING on the same
+frame without being woken up in between are reported as stuck. New and vanished
+threads are listed, as well as the threads whose blocked or waited count grew,
+i.e. threads that are busy but progressing. With several pods, the first thread
+dumps of all pods are taken before waiting, so all pods are compared over the
+same period and the duration is only waited once.`,
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := operations.complete(cmd); err != nil {
@@ -47,15 +86,29 @@ Displays thread information including thread states, blocked threads, and stack
 			if err := operations.validate(); err != nil {
 				return err
 			}
+			if operations.sample > 0 {
+				return operations.runSample(cmd.Context())
+			}
+			if operations.compareAfter > 0 {
+				return operations.runCompare(cmd.Context())
+			}
 			return RunForEachPod(cmd.Context(), operations.pods, "get threaddump", operations.runForPod)
 		},
 	}
 
-	cmd.Flags().StringVarP(&operations.output, "output", "o", "", "Output format. One of: wide")
+	cmd.Flags().StringVarP(&operations.output, "output", "o", "", "Output format. One of: wide, jstack")
 	cmd.Flags().StringVar(&operations.stateFilter, "state", "", "Filter by thread state (e.g., BLOCKED, WAITING, RUNNABLE)")
 	cmd.Flags().StringVar(&operations.nameFilter, "name", "", "Filter by thread name pattern")
 	cmd.Flags().BoolVar(&operations.summary, "summary", false, "Show only thread state summary")
 	cmd.Flags().BoolVar(&operations.noStacktrace, "no-stacktrace", false, "Show thread list without stack traces")
+	cmd.Flags().BoolVar(&operations.analyze, "analyze", false, "Detect deadlocks and show the most contended locks")
+	cmd.Flags().BoolVar(&operations.group, "group", false, "Group threads with identical state and stack trace")
+	cmd.Flags().IntVar(&operations.depth, "depth", 0, "Number of top stack frames to compare with --group (0 compares the full stack)")
+	cmd.Flags().IntVar(&operations.sample, "sample", 0, "Number of thread dumps to take to sample the RUNNABLE stacks")
+	cmd.Flags().DurationVar(&operations.interval, "interval", defaultSampleInterval, "Interval between thread dumps with --sample")
+	cmd.Flags().StringVar(&operations.outputDir, "output-dir", defaultSampleOutputDir, "Directory to write the folded stacks of --sample to")
+	cmd.Flags().StringVar(&operations.saveDir, "save", "", "Directory to write the thread dumps of -o jstack to")
+	cmd.Flags().DurationVar(&operations.compareAfter, "compare-after", 0, "Take a second thread dump after this duration and compare both (e.g., 10s)")
 
 	return cmd
 }
@@ -73,10 +126,59 @@ func (o *threaddumpCommandOperations) validate() error {
 		return err
 	}
 
-	if err := validateOutputFormat(o.output, OutputFormatWide); err != nil {
+	if err := validateOutputFormat(o.output, OutputFormatWide, OutputFormatJstack); err != nil {
 		return err
 	}
 
+	if o.output == OutputFormatJstack && (o.summary || o.analyze || o.group || o.sample > 0 || o.noStacktrace) {
+		return fmt.Errorf("-o %s cannot be used with --summary, --analyze, --group, --sample or --no-stacktrace", OutputFormatJstack)
+	}
+	if o.compareAfter < 0 {
+		return fmt.Errorf("--compare-after must be positive")
+	}
+	if o.compareAfter > 0 && (o.summary || o.analyze || o.group || o.sample > 0 || o.stateFilter != "" || o.output == OutputFormatJstack) {
+		return fmt.Errorf("--compare-after cannot be used with --summary, --analyze, --group, --sample, --state or -o jstack")
+	}
+
+	if o.saveDir != "" {
+		if o.output != OutputFormatJstack {
+			return fmt.Errorf("--save requires -o %s", OutputFormatJstack)
+		}
+		if info, err := os.Stat(o.saveDir); err == nil && !info.IsDir() {
+			return fmt.Errorf("output path %q is not a directory", o.saveDir)
+		}
+	}
+
+	if o.analyze && (o.summary || o.stateFilter != "" || o.nameFilter != "") {
+		return fmt.Errorf("--analyze cannot be used with --summary, --state or --name")
+	}
+
+	if o.group && (o.summary || o.analyze) {
+		return fmt.Errorf("--group cannot be used with --summary or --analyze")
+	}
+
+	if o.depth < 0 {
+		return fmt.Errorf("--depth must not be negative")
+	}
+	if o.depth > 0 && !o.group {
+		return fmt.Errorf("--depth requires --group")
+	}
+
+	if o.sample < 0 {
+		return fmt.Errorf("--sample must be positive")
+	}
+	if o.sample > 0 {
+		if o.summary || o.analyze || o.group || o.stateFilter != "" {
+			return fmt.Errorf("--sample cannot be used with --summary, --analyze, --group or --state")
+		}
+		if o.interval <= 0 {
+			return fmt.Errorf("--interval must be positive")
+		}
+		if info, err := os.Stat(o.outputDir); err == nil && !info.IsDir() {
+			return fmt.Errorf("output path %q is not a directory", o.outputDir)
+		}
+	}
+
 	if o.stateFilter != "" {
 		o.stateFilter = strings.ToUpper(o.stateFilter)
 		if !slices.Contains(validThreadStates, o.stateFilter) {
@@ -98,6 +200,9 @@ func (o *threaddumpCommandOperations) runForPod(ctx context.Context, podName str
 		return err
 	}
 
+	if o.output == OutputFormatJstack {
+		return o.writeJstack(podName, threaddump, time.Now())
+	}
 	return o.displayThreadDump(threaddump)
 }
 
@@ -110,6 +215,12 @@ func (o *threaddumpCommandOperations) displayThreadDump(threaddump *actuator.Thr
 		return nil
 	}
 
+	if o.analyze {
+		fmt.Println()
+		o.displayLockAnalysis(analyzeLocks(threaddump.Threads))
+		return nil
+	}
+
 	fmt.Println()
 
 	if len(filteredThreads) == 0 {
@@ -117,15 +228,24 @@ func (o *threaddumpCommandOperations) displayThreadDump(threaddump *actuator.Thr
 		return nil
 	}
 
-	if len(filteredThreads) < len(threaddump.Threads) {
-		fmt.Printf("Showing %d filtered threads:\n\n", len(filteredThreads))
-	}
-
 	maxFrames := defaultMaxStackFrames
 	if o.wideMode {
 		maxFrames = -1
 	}
 
+	if o.group {
+		groups := groupThreads(filteredThreads, o.depth)
+		fmt.Printf("Showing %d groups of %d threads:\n\n", len(groups), len(filteredThreads))
+		for i, group := range groups {
+			displayThreadGroup(group, i+1, o.wideMode, o.noStacktrace, maxFrames)
+		}
+		return nil
+	}
+
+	if len(filteredThreads) < len(threaddump.Threads) {
+		fmt.Printf("Showing %d filtered threads:\n\n", len(filteredThreads))
+	}
+
 	for i, thread := range filteredThreads {
 		displayThread(thread, i+1, o.wideMode, o.noStacktrace, maxFrames)
 	}
@@ -207,8 +327,7 @@ func displayStackTrace(frames []actuator.StackFrame, maxFrames int) {
 	}
 
 	for i := 0; i < framesToShow; i++ {
-		frame := frames[i]
-		fmt.Printf("    at %s.%s(%s)\n", frame.ClassName, frame.MethodName, formatFrameLocation(frame))
+		fmt.Printf("    at %s\n", formatFrame(frames[i]))
 	}
 
 	if len(frames) > framesToShow {
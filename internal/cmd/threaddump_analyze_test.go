@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func testFrame(className, methodName string, line int) actuator.StackFrame {
+	fileName := className[strings.LastIndex(className, ".")+1:] + ".java"
+	return actuator.StackFrame{ClassName: className, MethodName: methodName, FileName: &fileName, LineNumber: &line}
+}
+
+func testMonitor(className string, identityHashCode int64, depth int, frame actuator.StackFrame) actuator.MonitorInfo {
+	return actuator.MonitorInfo{
+		LockInfo:         actuator.LockInfo{ClassName: className, IdentityHashCode: identityHashCode},
+		LockedStackDepth: depth,
+		LockedStackFrame: &frame,
+	}
+}
+
+// testLockThreads returns two deadlocked threads transferring between two accounts, two request threads
+// waiting for the first of them and a third thread waiting for a ReentrantLock
+func testLockThreads() []actuator.Thread {
+	debit := testFrame("com.example.Transfer", "debit", 42)
+	credit := testFrame("com.example.Transfer", "credit", 50)
+
+	return []actuator.Thread{
+		{
+			ThreadName: "main", ThreadID: 1, ThreadState: "RUNNABLE", LockOwnerId: -1,
+		},
+		{
+			ThreadName: "transfer-1", ThreadID: 31, ThreadState: "BLOCKED", LockOwnerId: 32,
+			LockInfo:       &actuator.LockInfo{ClassName: "com.example.Account", IdentityHashCode: 0xbb},
+			StackTrace:     []actuator.StackFrame{credit, debit},
+			LockedMonitors: []actuator.MonitorInfo{testMonitor("com.example.Account", 0xaa, 1, debit)},
+		},
+		{
+			ThreadName: "transfer-2", ThreadID: 32, ThreadState: "BLOCKED", LockOwnerId: 31,
+			LockInfo:       &actuator.LockInfo{ClassName: "com.example.Account", IdentityHashCode: 0xaa},
+			StackTrace:     []actuator.StackFrame{credit, debit},
+			LockedMonitors: []actuator.MonitorInfo{testMonitor("com.example.Account", 0xbb, 1, debit)},
+		},
+		{
+			ThreadName: "http-nio-8080-exec-1", ThreadID: 40, ThreadState: "BLOCKED", LockOwnerId: 31,
+			LockInfo: &actuator.LockInfo{ClassName: "com.example.Account", IdentityHashCode: 0xaa},
+		},
+		{
+			ThreadName: "http-nio-8080-exec-2", ThreadID: 41, ThreadState: "BLOCKED", LockOwnerId: 31,
+			LockName: "com.example.Account@aa",
+		},
+		{
+			ThreadName: "scheduler-1", ThreadID: 50, ThreadState: "WAITING", LockOwnerId: 1,
+			LockInfo: &actuator.LockInfo{ClassName: "java.util.concurrent.locks.ReentrantLock$NonfairSync", IdentityHashCode: 0x10},
+		},
+	}
+}
+
+func TestAnalyzeLocks(t *testing.T) {
+	analysis := analyzeLocks(testLockThreads())
+
+	if len(analysis.deadlocks) != 1 {
+		t.Fatalf("expected 1 deadlock, got %d", len(analysis.deadlocks))
+	}
+	var cycle []string
+	for _, thread := range analysis.deadlocks[0] {
+		cycle = append(cycle, thread.ThreadName)
+	}
+	if strings.Join(cycle, ",") != "transfer-1,transfer-2" {
+		t.Errorf("deadlock = %v, want [transfer-1 transfer-2]", cycle)
+	}
+
+	if len(analysis.contention) != 3 {
+		t.Fatalf("expected 3 contended locks, got %d", len(analysis.contention))
+	}
+	top := analysis.contention[0]
+	if top.lock != "com.example.Account@aa" || top.owner.ThreadName != "transfer-1" || len(top.waiters) != 3 {
+		t.Errorf("unexpected top contended lock %s owned by %s with %d waiters", top.lock, top.owner.ThreadName, len(top.waiters))
+	}
+	if top.lockedAt == nil || top.lockedAt.MethodName != "debit" {
+		t.Errorf("expected the lock to be entered in debit, got %+v", top.lockedAt)
+	}
+	if last := analysis.contention[2]; last.lockedAt != nil {
+		t.Errorf("expected no stack frame for a synchronizer, got %+v", last.lockedAt)
+	}
+}
+
+func TestAnalyzeLocksWithoutDeadlock(t *testing.T) {
+	threads := testLockThreads()
+	// transfer-2 no longer waits for transfer-1, so the chain ends there
+	threads[2].LockOwnerId = -1
+
+	analysis := analyzeLocks(threads)
+
+	if len(analysis.deadlocks) != 0 {
+		t.Errorf("expected no deadlocks, got %d", len(analysis.deadlocks))
+	}
+	if len(analysis.contention) != 3 {
+		t.Errorf("expected 3 contended locks, got %d", len(analysis.contention))
+	}
+}
+
+func TestDisplayLockAnalysis(t *testing.T) {
+	ops := &threaddumpCommandOperations{}
+
+	output := captureOutput(func() {
+		ops.displayLockAnalysis(analyzeLocks(testLockThreads()))
+	})
+
+	expectedRegex := []string{
+		`Deadlocks: 1`,
+		`Deadlock #1 \(2 threads\):`,
+		`"transfer-1" \(ID: 31\) is BLOCKED on com\.example\.Account@bb owned by "transfer-2" \(ID: 32\)`,
+		`"transfer-2" \(ID: 32\) is BLOCKED on com\.example\.Account@aa owned by "transfer-1" \(ID: 31\)`,
+		`at com\.example\.Transfer\.credit\(Transfer\.java:50\)`,
+		`LOCK\s+OWNER\s+OWNER STATE\s+WAITING\s+LOCKED AT`,
+		`com\.example\.Account@aa\s+transfer-1 \(ID: 31\)\s+BLOCKED\s+3\s+com\.example\.Transfer\.debit\(Transfer\.java:42\)`,
+		`ReentrantLock\$NonfairSync@10\s+main \(ID: 1\)\s+RUNNABLE\s+1\s+-`,
+	}
+	for _, pattern := range expectedRegex {
+		if !regexp.MustCompile(pattern).MatchString(output) {
+			t.Errorf("expected output to match %q, got:\n%s", pattern, output)
+		}
+	}
+	if strings.Contains(output, "WAITING THREADS") {
+		t.Errorf("expected the waiting threads only in wide mode, got:\n%s", output)
+	}
+}
+
+func TestDisplayLockAnalysisWithoutContention(t *testing.T) {
+	ops := &threaddumpCommandOperations{}
+
+	output := captureOutput(func() {
+		ops.displayLockAnalysis(analyzeLocks([]actuator.Thread{{ThreadName: "main", ThreadID: 1, LockOwnerId: -1}}))
+	})
+
+	if !strings.Contains(output, "Deadlocks: 0") || !strings.Contains(output, "No threads are waiting for locks owned by other threads.") {
+		t.Errorf("unexpected output:\n%s", output)
+	}
+}
+
+func TestThreadDumpAnalyzeValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  threaddumpCommandOperations
+	}{
+		{name: "with summary", ops: threaddumpCommandOperations{summary: true}},
+		{name: "with state", ops: threaddumpCommandOperations{stateFilter: "BLOCKED"}},
+		{name: "with name", ops: threaddumpCommandOperations{nameFilter: "http"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := tt.ops
+			ops.pods = []string{"pod-1"}
+			ops.analyze = true
+
+			err := ops.validate()
+			if err == nil || !strings.Contains(err.Error(), "--analyze cannot be used with --summary, --state or --name") {
+				t.Errorf("validate() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+// fakeThreadDumpClient returns the given thread dumps one after another and records each request in calls
+type fakeThreadDumpClient struct {
+	actuator.Client
+	name  string
+	dumps []*actuator.ThreadDumpResponse
+	err   error
+	calls *[]string
+}
+
+func (f *fakeThreadDumpClient) GetThreadDump() (*actuator.ThreadDumpResponse, error) {
+	if f.calls != nil {
+		*f.calls = append(*f.calls, f.name)
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	dump := f.dumps[0]
+	f.dumps = f.dumps[1:]
+	return dump, nil
+}
+
+func testCompareDumps() (*actuator.ThreadDumpResponse, *actuator.ThreadDumpResponse) {
+	query := testFrame("org.postgresql.core.PGStream", "receiveChar", 120)
+	take := testFrame("java.util.concurrent.LinkedBlockingQueue", "take", 435)
+	account := &actuator.LockInfo{ClassName: "com.example.Account", IdentityHashCode: 0xaa}
+
+	before := &actuator.ThreadDumpResponse{Threads: []actuator.Thread{
+		{ThreadName: "http-nio-8080-exec-1", ThreadID: 40, ThreadState: "BLOCKED", BlockedCount: 3, LockInfo: account, StackTrace: []actuator.StackFrame{query}},
+		{ThreadName: "http-nio-8080-exec-2", ThreadID: 41, ThreadState: "WAITING", WaitedCount: 10, StackTrace: []actuator.StackFrame{take}},
+		{ThreadName: "http-nio-8080-exec-3", ThreadID: 42, ThreadState: "RUNNABLE", BlockedCount: 1, StackTrace: []actuator.StackFrame{query}},
+		{ThreadName: "scheduling-1", ThreadID: 50, ThreadState: "TIMED_WAITING", WaitedCount: 2, StackTrace: []actuator.StackFrame{take}},
+		{ThreadName: "batch-1", ThreadID: 60, ThreadState: "RUNNABLE"},
+	}}
+	after := &actuator.ThreadDumpResponse{Threads: []actuator.Thread{
+		// Still waiting for the same lock
+		{ThreadName: "http-nio-8080-exec-1", ThreadID: 40, ThreadState: "BLOCKED", BlockedCount: 3, LockInfo: account, StackTrace: []actuator.StackFrame{query}},
+		// Waiting on the same frame, but it handled requests in between
+		{ThreadName: "http-nio-8080-exec-2", ThreadID: 41, ThreadState: "WAITING", WaitedCount: 25, StackTrace: []actuator.StackFrame{take}},
+		{ThreadName: "http-nio-8080-exec-3", ThreadID: 42, ThreadState: "RUNNABLE", BlockedCount: 6, WaitedCount: 1, StackTrace: []actuator.StackFrame{query}},
+		// Changed from TIMED_WAITING to WAITING
+		{ThreadName: "scheduling-1", ThreadID: 50, ThreadState: "WAITING", WaitedCount: 2, StackTrace: []actuator.StackFrame{take}},
+		{ThreadName: "batch-2", ThreadID: 61, ThreadState: "RUNNABLE"},
+	}}
+	return before, after
+}
+
+func TestCompareThreadDumps(t *testing.T) {
+	before, after := testCompareDumps()
+
+	diff := compareThreadDumps(before.Threads, after.Threads)
+
+	names := func(threads []actuator.Thread) string {
+		var result []string
+		for _, thread := range threads {
+			result = append(result, thread.ThreadName)
+		}
+		return strings.Join(result, ",")
+	}
+
+	if got := names(diff.stuck); got != "http-nio-8080-exec-1" {
+		t.Errorf("stuck = %s, want http-nio-8080-exec-1", got)
+	}
+	if got := names(diff.added); got != "batch-2" {
+		t.Errorf("added = %s, want batch-2", got)
+	}
+	if got := names(diff.vanished); got != "batch-1" {
+		t.Errorf("vanished = %s, want batch-1", got)
+	}
+
+	if len(diff.growth) != 2 {
+		t.Fatalf("expected 2 progressing threads, got %d", len(diff.growth))
+	}
+	if g := diff.growth[0]; g.thread.ThreadID != 41 || g.blocked != 0 || g.waited != 15 {
+		t.Errorf("unexpected growth %+v", g)
+	}
+	if g := diff.growth[1]; g.thread.ThreadID != 42 || g.blocked != 5 || g.waited != 1 {
+		t.Errorf("unexpected growth %+v", g)
+	}
+}
+
+func TestCompareWithLaterDump(t *testing.T) {
+	before, after := testCompareDumps()
+	client := &fakeThreadDumpClient{dumps: []*actuator.ThreadDumpResponse{before, after}}
+	ops := &threaddumpCommandOperations{compareAfter: time.Millisecond}
+
+	var failed int
+	var err error
+	output := captureOutput(func() {
+		failed, err = ops.compareWithLaterDumps(context.Background(), []string{"pod-1"}, map[string]actuator.Client{"pod-1": client})
+	})
+	if err != nil || failed != 0 {
+		t.Fatalf("compareWithLaterDumps() = %d, %v", failed, err)
+	}
+	if strings.Contains(output, "pod-1:") {
+		t.Errorf("expected no pod header for a single pod, got:\n%s", output)
+	}
+
+	expectedRegex := []string{
+		`Compared thread dumps taken 1ms apart: 5 threads before, 5 after`,
+		`(?s)STUCK THREADS\nTHREAD\s+ID\s+STATE\s+FRAME\s+LOCK\n` +
+			`http-nio-8080-exec-1\s+40\s+BLOCKED\s+org\.postgresql\.core\.PGStream\.receiveChar\(PGStream\.java:120\)\s+com\.example\.Account@aa\n`,
+		`(?s)PROGRESSING THREADS\nTHREAD\s+ID\s+STATE\s+BLOCKED\s+WAITED\n` +
+			`http-nio-8080-exec-2\s+41\s+WAITING\s+\+0 \(0\)\s+\+15 \(25\)\n` +
+			`http-nio-8080-exec-3\s+42\s+RUNNABLE\s+\+5 \(6\)\s+\+1 \(1\)\n`,
+		`(?s)NEW THREADS\nTHREAD\s+ID\s+STATE\nbatch-2\s+61\s+RUNNABLE`,
+		`(?s)VANISHED THREADS\nTHREAD\s+ID\s+STATE\nbatch-1\s+60\s+RUNNABLE`,
+	}
+	for _, pattern := range expectedRegex {
+		if !regexp.MustCompile(pattern).MatchString(output) {
+			t.Errorf("expected output to match %q, got:\n%s", pattern, output)
+		}
+	}
+}
+
+func TestCompareWithLaterDumpNameFilter(t *testing.T) {
+	before, after := testCompareDumps()
+	client := &fakeThreadDumpClient{dumps: []*actuator.ThreadDumpResponse{before, after}}
+	ops := &threaddumpCommandOperations{compareAfter: time.Millisecond, nameFilter: "batch"}
+
+	output := captureOutput(func() {
+		_, _ = ops.compareWithLaterDumps(context.Background(), []string{"pod-1"}, map[string]actuator.Client{"pod-1": client})
+	})
+
+	for _, want := range []string{
+		"1 threads before, 1 after",
+		"No threads stayed BLOCKED or WAITING on the same frame.",
+		"No thread was blocked or waited in between.",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "http-nio") {
+		t.Errorf("expected only batch threads, got:\n%s", output)
+	}
+}
+
+func TestCompareWithLaterDumpCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ops := &threaddumpCommandOperations{compareAfter: time.Hour}
+
+	client := &fakeThreadDumpClient{dumps: []*actuator.ThreadDumpResponse{{}}}
+	_, err := ops.compareWithLaterDumps(ctx, []string{"pod-1"}, map[string]actuator.Client{"pod-1": client})
+	if err != context.Canceled {
+		t.Errorf("compareWithLaterDumps() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestCompareWithLaterDumpsOfSeveralPods(t *testing.T) {
+	before, after := testCompareDumps()
+	var calls []string
+	clients := map[string]actuator.Client{
+		"pod-1": &fakeThreadDumpClient{name: "pod-1", dumps: []*actuator.ThreadDumpResponse{before, after}, calls: &calls},
+		"pod-2": &fakeThreadDumpClient{name: "pod-2", err: errors.New("connection refused"), calls: &calls},
+		"pod-3": &fakeThreadDumpClient{name: "pod-3", dumps: []*actuator.ThreadDumpResponse{before, after}, calls: &calls},
+	}
+	ops := &threaddumpCommandOperations{compareAfter: time.Millisecond}
+
+	var failed int
+	var err error
+	output := captureOutput(func() {
+		failed, err = ops.compareWithLaterDumps(context.Background(), []string{"pod-1", "pod-2", "pod-3"}, clients)
+	})
+	if err != nil || failed != 1 {
+		t.Fatalf("compareWithLaterDumps() = %d, %v, want 1 failed pod", failed, err)
+	}
+
+	// All first thread dumps are taken before the second ones, and a failed pod is not asked again
+	if got := strings.Join(calls, ","); got != "pod-1,pod-2,pod-3,pod-1,pod-3" {
+		t.Errorf("thread dump requests = %s", got)
+	}
+
+	pattern := `(?s)^pod-1:\nCompared thread dumps.*\n\npod-2:\nError: connection refused\n\npod-3:\nCompared thread dumps`
+	if !regexp.MustCompile(pattern).MatchString(output) {
+		t.Errorf("expected output to match %q, got:\n%s", pattern, output)
+	}
+}
+
+func TestThreadDumpCompareValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		ops         threaddumpCommandOperations
+		errContains string
+	}{
+		{name: "compare with name filter", ops: threaddumpCommandOperations{compareAfter: 10 * time.Second, nameFilter: "http"}},
+		{name: "negative duration", ops: threaddumpCommandOperations{compareAfter: -time.Second}, errContains: "--compare-after must be positive"},
+		{name: "compare with state", ops: threaddumpCommandOperations{compareAfter: time.Second, stateFilter: "BLOCKED"}, errContains: "--compare-after cannot be used with --summary, --analyze, --group, --sample, --state or -o jstack"},
+		{name: "compare with jstack", ops: threaddumpCommandOperations{compareAfter: time.Second, output: "jstack"}, errContains: "--compare-after cannot be used with"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := tt.ops
+			ops.pods = []string{"pod-1"}
+
+			err := ops.validate()
+			if tt.errContains == "" {
+				if err != nil {
+					t.Errorf("validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("validate() error = %v, want error containing %q", err, tt.errContains)
+			}
+		})
+	}
+}
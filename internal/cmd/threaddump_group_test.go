@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func testGroupThreads() []actuator.Thread {
+	query := testFrame("org.postgresql.core.PGStream", "receiveChar", 120)
+	execute := testFrame("org.postgresql.jdbc.PgStatement", "executeQuery", 240)
+	orders := testFrame("com.example.OrderRepository", "findAll", 30)
+	users := testFrame("com.example.UserRepository", "findAll", 18)
+	park := testFrame("jdk.internal.misc.Unsafe", "park", 0)
+
+	var threads []actuator.Thread
+	for i := 1; i <= 7; i++ {
+		threads = append(threads, actuator.Thread{
+			ThreadName:  fmt.Sprintf("http-nio-8080-exec-%d", i),
+			ThreadState: "RUNNABLE",
+			StackTrace:  []actuator.StackFrame{query, execute, orders},
+		})
+	}
+	threads = append(threads,
+		actuator.Thread{ThreadName: "http-nio-8080-exec-8", ThreadState: "RUNNABLE", StackTrace: []actuator.StackFrame{query, execute, users}},
+		actuator.Thread{ThreadName: "scheduling-1", ThreadState: "WAITING", StackTrace: []actuator.StackFrame{park}},
+		actuator.Thread{ThreadName: "scheduling-2", ThreadState: "TIMED_WAITING", StackTrace: []actuator.StackFrame{park}},
+	)
+	return threads
+}
+
+func TestGroupThreads(t *testing.T) {
+	tests := []struct {
+		name  string
+		depth int
+		want  []int
+	}{
+		{name: "full stack", depth: 0, want: []int{7, 1, 1, 1}},
+		{name: "top frames", depth: 2, want: []int{8, 1, 1}},
+		{name: "depth beyond stack", depth: 50, want: []int{7, 1, 1, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups := groupThreads(testGroupThreads(), tt.depth)
+
+			var sizes []int
+			for _, group := range groups {
+				sizes = append(sizes, len(group.threads))
+			}
+			if fmt.Sprint(sizes) != fmt.Sprint(tt.want) {
+				t.Errorf("group sizes = %v, want %v", sizes, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupThreadsSeparatesStates(t *testing.T) {
+	groups := groupThreads(testGroupThreads(), 0)
+
+	// The scheduling threads share the stack but not the state
+	last := groups[len(groups)-2:]
+	if last[0].state != "WAITING" || last[1].state != "TIMED_WAITING" {
+		t.Errorf("expected separate groups per state in order of appearance, got %s and %s", last[0].state, last[1].state)
+	}
+}
+
+func TestDisplayThreadDumpGrouped(t *testing.T) {
+	tests := []struct {
+		name          string
+		ops           threaddumpCommandOperations
+		expectedRegex []string
+		unexpected    []string
+	}{
+		{
+			name: "group",
+			ops:  threaddumpCommandOperations{group: true},
+			expectedRegex: []string{
+				`Showing 4 groups of 10 threads:`,
+				`Group #1: 7 thread\(s\)\n  State: RUNNABLE\n  Threads: http-nio-8080-exec-1, http-nio-8080-exec-2, http-nio-8080-exec-3, http-nio-8080-exec-4, http-nio-8080-exec-5, \.\.\. 2 more\n`,
+				`at com\.example\.OrderRepository\.findAll\(OrderRepository\.java:30\)`,
+				`Group #4: 1 thread\(s\)\n  State: TIMED_WAITING\n  Threads: scheduling-2\n`,
+			},
+			unexpected: []string{"Thread #"},
+		},
+		{
+			name: "group with depth and wide output",
+			ops:  threaddumpCommandOperations{group: true, depth: 2, wideMode: true},
+			expectedRegex: []string{
+				`Showing 3 groups of 10 threads:`,
+				`Group #1: 8 thread\(s\)`,
+				`Threads: http-nio-8080-exec-1, .*, http-nio-8080-exec-8\n`,
+			},
+			unexpected: []string{"Repository", "more"},
+		},
+		{
+			name: "group with name filter",
+			ops:  threaddumpCommandOperations{group: true, nameFilter: "scheduling"},
+			expectedRegex: []string{
+				`Showing 2 groups of 2 threads:`,
+			},
+			unexpected: []string{"http-nio"},
+		},
+		{
+			name: "group without stack traces",
+			ops:  threaddumpCommandOperations{group: true, noStacktrace: true},
+			expectedRegex: []string{
+				`Group #1: 7 thread\(s\)`,
+			},
+			unexpected: []string{"Stack Trace:"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := tt.ops
+			output := captureOutput(func() {
+				_ = ops.displayThreadDump(&actuator.ThreadDumpResponse{Threads: testGroupThreads()})
+			})
+
+			for _, pattern := range tt.expectedRegex {
+				if !regexp.MustCompile(pattern).MatchString(output) {
+					t.Errorf("expected output to match %q, got:\n%s", pattern, output)
+				}
+			}
+			for _, text := range tt.unexpected {
+				if strings.Contains(output, text) {
+					t.Errorf("expected output not to contain %q, got:\n%s", text, output)
+				}
+			}
+		})
+	}
+}
+
+func TestThreadDumpGroupValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		ops         threaddumpCommandOperations
+		errContains string
+	}{
+		{name: "group with summary", ops: threaddumpCommandOperations{group: true, summary: true}, errContains: "--group cannot be used with --summary or --analyze"},
+		{name: "group with analyze", ops: threaddumpCommandOperations{group: true, analyze: true}, errContains: "--group cannot be used with --summary or --analyze"},
+		{name: "depth without group", ops: threaddumpCommandOperations{depth: 5}, errContains: "--depth requires --group"},
+		{name: "negative depth", ops: threaddumpCommandOperations{group: true, depth: -1}, errContains: "--depth must not be negative"},
+		{name: "group with depth and state", ops: threaddumpCommandOperations{group: true, depth: 5, stateFilter: "blocked"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := tt.ops
+			ops.pods = []string{"pod-1"}
+
+			err := ops.validate()
+			if tt.errContains == "" {
+				if err != nil {
+					t.Errorf("validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("validate() error = %v, want error containing %q", err, tt.errContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func TestFormatJstack(t *testing.T) {
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	threads := testLockThreads()
+	threads[1].Daemon = true
+	threads[1].Priority = 5
+	threads = append(threads, actuator.Thread{
+		ThreadName: "queue-consumer", ThreadID: 60, ThreadState: "WAITING", Priority: 5, LockOwnerId: -1,
+		LockInfo: &actuator.LockInfo{ClassName: "java.util.LinkedList", IdentityHashCode: 0x1c},
+		StackTrace: []actuator.StackFrame{
+			{ClassName: "java.lang.Object", MethodName: "wait0", NativeMethod: true},
+			testFrame("com.example.Consumer", "take", 12),
+		},
+		LockedMonitors: []actuator.MonitorInfo{{
+			LockInfo: actuator.LockInfo{ClassName: "java.util.LinkedList", IdentityHashCode: 0x1c}, LockedStackDepth: 1,
+		}},
+		LockedSynchronizers: []actuator.LockInfo{{ClassName: "java.util.concurrent.locks.ReentrantLock$NonfairSync", IdentityHashCode: 0x10}},
+	})
+
+	var out strings.Builder
+	formatJstack(&out, threads, threads, now)
+	output := out.String()
+
+	expected := []string{
+		"2024-01-15 10:30:00\nFull thread dump:\n\n",
+		`"main" #1 prio=0 tid=0x0000000000000001 nid=0x1 runnable` + "\n   java.lang.Thread.State: RUNNABLE\n",
+		`"transfer-1" #31 daemon prio=5 tid=0x000000000000001f nid=0x1f waiting for monitor entry` + "\n" +
+			"   java.lang.Thread.State: BLOCKED (on object monitor)\n" +
+			"\tat com.example.Transfer.credit(Transfer.java:50)\n" +
+			"\t- waiting to lock <0x00000000000000bb> (a com.example.Account)\n" +
+			"\tat com.example.Transfer.debit(Transfer.java:42)\n",
+		"\t- locked <0x00000000000000aa> (a com.example.Account)\n\n   Locked ownable synchronizers:\n\t- None\n",
+		`"scheduler-1" #50 prio=0 tid=0x0000000000000032 nid=0x32 waiting on condition` + "\n   java.lang.Thread.State: WAITING (parking)\n",
+		`"queue-consumer" #60 prio=5 tid=0x000000000000003c nid=0x3c in Object.wait()` + "\n" +
+			"   java.lang.Thread.State: WAITING (on object monitor)\n" +
+			"\tat java.lang.Object.wait0(Native Method)\n" +
+			"\t- waiting on <0x000000000000001c> (a java.util.LinkedList)\n" +
+			"\tat com.example.Consumer.take(Consumer.java:12)\n" +
+			"\t- locked <0x000000000000001c> (a java.util.LinkedList)\n\n" +
+			"   Locked ownable synchronizers:\n" +
+			"\t- <0x0000000000000010> (a java.util.concurrent.locks.ReentrantLock$NonfairSync)\n",
+		"Found one Java-level deadlock:\n=============================\n" +
+			"\"transfer-1\":\n" +
+			"  waiting to lock monitor 0x00000000000000bb (object 0x00000000000000bb, a com.example.Account),\n" +
+			"  which is held by \"transfer-2\"\n" +
+			"\"transfer-2\":\n",
+		"Java stack information for the threads listed above:\n===================================================\n\"transfer-1\":\n\tat com.example.Transfer.credit",
+		"Found 1 deadlock.\n",
+	}
+	for _, want := range expected {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestFormatJstackWithoutDeadlock(t *testing.T) {
+	threads := []actuator.Thread{{ThreadName: "main", ThreadID: 1, ThreadState: "RUNNABLE", LockOwnerId: -1}}
+
+	var out strings.Builder
+	formatJstack(&out, threads, threads, time.Now())
+
+	if strings.Contains(out.String(), "deadlock") {
+		t.Errorf("expected no deadlock section, got:\n%s", out.String())
+	}
+}
+
+func TestJstackStatus(t *testing.T) {
+	tests := []struct {
+		state      string
+		topFrame   string
+		wantStatus string
+		wantDetail string
+	}{
+		{state: "RUNNABLE", wantStatus: "runnable"},
+		{state: "BLOCKED", wantStatus: "waiting for monitor entry", wantDetail: " (on object monitor)"},
+		{state: "TIMED_WAITING", topFrame: "sleep", wantStatus: "waiting on condition", wantDetail: " (sleeping)"},
+		{state: "TIMED_WAITING", topFrame: "wait", wantStatus: "in Object.wait()", wantDetail: " (on object monitor)"},
+		{state: "WAITING", topFrame: "park", wantStatus: "waiting on condition", wantDetail: " (parking)"},
+		{state: "NEW", wantStatus: "new"},
+	}
+
+	frames := map[string]actuator.StackFrame{
+		"sleep": {ClassName: "java.lang.Thread", MethodName: "sleep"},
+		"wait":  {ClassName: "java.lang.Object", MethodName: "wait"},
+		"park":  {ClassName: "jdk.internal.misc.Unsafe", MethodName: "park"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.state+" "+tt.topFrame, func(t *testing.T) {
+			thread := actuator.Thread{ThreadState: tt.state}
+			if tt.topFrame != "" {
+				thread.StackTrace = []actuator.StackFrame{frames[tt.topFrame]}
+			}
+
+			status, detail := jstackStatus(thread)
+			if status != tt.wantStatus || detail != tt.wantDetail {
+				t.Errorf("jstackStatus() = %q, %q, want %q, %q", status, detail, tt.wantStatus, tt.wantDetail)
+			}
+		})
+	}
+}
+
+func TestWriteJstackSave(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "dumps")
+	now := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	ops := &threaddumpCommandOperations{output: OutputFormatJstack, saveDir: dir, stateFilter: "BLOCKED"}
+
+	var err error
+	output := captureOutput(func() {
+		err = ops.writeJstack("pod-1", &actuator.ThreadDumpResponse{Threads: testLockThreads()}, now)
+	})
+	if err != nil {
+		t.Fatalf("writeJstack() error = %v", err)
+	}
+
+	path := filepath.Join(dir, "pod-1-20240115-103000.tdump")
+	if !strings.Contains(output, "Thread dump saved to "+path+" (4 threads)") {
+		t.Errorf("unexpected output: %s", output)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected thread dump file: %v", err)
+	}
+	if strings.Contains(string(content), `"main"`) || !strings.Contains(string(content), "Found 1 deadlock.") {
+		t.Errorf("unexpected thread dump file:\n%s", content)
+	}
+}
+
+func TestThreadDumpJstackValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		ops         threaddumpCommandOperations
+		errContains string
+	}{
+		{name: "jstack with filter", ops: threaddumpCommandOperations{output: "jstack", nameFilter: "http"}},
+		{name: "jstack with save", ops: threaddumpCommandOperations{output: "jstack", saveDir: "dumps"}},
+		{name: "jstack with analyze", ops: threaddumpCommandOperations{output: "jstack", analyze: true}, errContains: "-o jstack cannot be used with --summary, --analyze, --group, --sample or --no-stacktrace"},
+		{name: "jstack with sample", ops: threaddumpCommandOperations{output: "jstack", sample: 5, interval: time.Second}, errContains: "-o jstack cannot be used with"},
+		{name: "save without jstack", ops: threaddumpCommandOperations{saveDir: "dumps"}, errContains: "--save requires -o jstack"},
+		{name: "save to a file", ops: threaddumpCommandOperations{output: "jstack", saveDir: "threaddump.go"}, errContains: "is not a directory"},
+		{name: "unknown format", ops: threaddumpCommandOperations{output: "json"}, errContains: "not recognized"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := tt.ops
+			ops.pods = []string{"pod-1"}
+
+			err := ops.validate()
+			if tt.errContains == "" {
+				if err != nil {
+					t.Errorf("validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("validate() error = %v, want error containing %q", err, tt.errContains)
+			}
+		})
+	}
+}
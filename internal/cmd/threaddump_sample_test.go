@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+func testSampleThreads() []actuator.Thread {
+	read := testFrame("java.net.SocketInputStream", "read", 10)
+	query := testFrame("com.example.OrderRepository", "findAll", 30)
+	parse := testFrame("com.example.JsonParser", "parse", 12)
+	handle := testFrame("com.example.OrderController", "list", 20)
+	run := testFrame("java.lang.Thread", "run", 1)
+
+	return []actuator.Thread{
+		{ThreadName: "http-nio-8080-exec-1", ThreadState: "RUNNABLE", StackTrace: []actuator.StackFrame{read, query, handle, run}},
+		{ThreadName: "http-nio-8080-exec-2", ThreadState: "RUNNABLE", StackTrace: []actuator.StackFrame{parse, parse, handle, run}},
+		{ThreadName: "http-nio-8080-exec-3", ThreadState: "WAITING", StackTrace: []actuator.StackFrame{read, query, handle, run}},
+		{ThreadName: "http-nio-8080-exec-4", ThreadState: "RUNNABLE", StackTrace: []actuator.StackFrame{
+			testFrame(threadDumpEndpointClass, "threadDump", 40), run,
+		}},
+		{ThreadName: "scheduling-1", ThreadState: "RUNNABLE", StackTrace: []actuator.StackFrame{read, query, run}},
+	}
+}
+
+func TestStackProfile(t *testing.T) {
+	profile := newStackProfile()
+	profile.add(testSampleThreads(), "")
+	profile.add(testSampleThreads(), "")
+
+	if profile.samples != 2 || profile.stackSamples != 6 {
+		t.Errorf("samples = %d, stack samples = %d, want 2 and 6", profile.samples, profile.stackSamples)
+	}
+
+	var folded strings.Builder
+	profile.writeFolded(&folded)
+	want := strings.Join([]string{
+		"java.lang.Thread.run;com.example.OrderController.list;com.example.JsonParser.parse;com.example.JsonParser.parse 2",
+		"java.lang.Thread.run;com.example.OrderController.list;com.example.OrderRepository.findAll;java.net.SocketInputStream.read 2",
+		"java.lang.Thread.run;com.example.OrderRepository.findAll;java.net.SocketInputStream.read 2",
+		"",
+	}, "\n")
+	if folded.String() != want {
+		t.Errorf("folded stacks =\n%s\nwant\n%s", folded.String(), want)
+	}
+
+	if profile.self["java.net.SocketInputStream.read"] != 4 || profile.total["java.lang.Thread.run"] != 6 {
+		t.Errorf("unexpected counts: self %v, total %v", profile.self, profile.total)
+	}
+	// The recursive parse method is counted once per stack
+	if profile.total["com.example.JsonParser.parse"] != 2 {
+		t.Errorf("total of recursive method = %d, want 2", profile.total["com.example.JsonParser.parse"])
+	}
+}
+
+func TestStackProfileNameFilter(t *testing.T) {
+	profile := newStackProfile()
+	profile.add(testSampleThreads(), "scheduling")
+
+	if profile.stackSamples != 1 || len(profile.stacks) != 1 {
+		t.Errorf("expected only the scheduling thread to be sampled, got %v", profile.stacks)
+	}
+}
+
+func TestDisplayHotMethods(t *testing.T) {
+	profile := newStackProfile()
+	profile.add(testSampleThreads(), "")
+
+	output := captureOutput(func() {
+		profile.displayHotMethods(false)
+	})
+
+	expectedRegex := []string{
+		`METHOD\s+SELF\s+SELF%\s+TOTAL\s+TOTAL%`,
+		`java\.net\.SocketInputStream\.read\s+2\s+66\.7%\s+2\s+66\.7%`,
+		`com\.example\.JsonParser\.parse\s+1\s+33\.3%\s+1\s+33\.3%`,
+		`java\.lang\.Thread\.run\s+0\s+0\.0%\s+3\s+100\.0%`,
+		`(?s)SocketInputStream.*JsonParser.*Thread\.run`,
+	}
+	for _, pattern := range expectedRegex {
+		if !regexp.MustCompile(pattern).MatchString(output) {
+			t.Errorf("expected output to match %q, got:\n%s", pattern, output)
+		}
+	}
+}
+
+func TestWriteProfile(t *testing.T) {
+	dir := t.TempDir()
+	timestamp := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	ops := &threaddumpCommandOperations{outputDir: dir}
+
+	profile := newStackProfile()
+	profile.add(testSampleThreads(), "")
+	profile.failed = 1
+	profile.err = errors.New("failed to get thread dump: 503")
+
+	var err error
+	output := captureOutput(func() {
+		err = ops.writeProfile("pod-1", profile, timestamp)
+	})
+	if err != nil {
+		t.Fatalf("writeProfile() error = %v", err)
+	}
+
+	path := filepath.Join(dir, "pod-1-20240115-103000.folded")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected folded stacks file: %v", err)
+	}
+	if lines := strings.Count(string(content), "\n"); lines != 3 {
+		t.Errorf("expected 3 folded stacks, got %d:\n%s", lines, content)
+	}
+
+	for _, want := range []string{
+		"Samples: 1, RUNNABLE stacks: 3",
+		"Failed thread dumps: 1 (last error: failed to get thread dump: 503)",
+		"Folded stacks saved to " + path,
+		"HOT METHODS",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestWriteProfileWithoutSamples(t *testing.T) {
+	ops := &threaddumpCommandOperations{outputDir: t.TempDir()}
+	profile := newStackProfile()
+	profile.err = errors.New("failed to get thread dump: 404")
+
+	err := ops.writeProfile("pod-1", profile, time.Now())
+	if err == nil || !strings.Contains(err.Error(), "no thread dump could be taken: failed to get thread dump: 404") {
+		t.Errorf("writeProfile() error = %v", err)
+	}
+}
+
+func TestThreadDumpSampleValidation(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		ops         threaddumpCommandOperations
+		errContains string
+	}{
+		{name: "sample with name filter", ops: threaddumpCommandOperations{sample: 10, interval: time.Second, outputDir: ".", nameFilter: "http"}},
+		{name: "negative sample", ops: threaddumpCommandOperations{sample: -1}, errContains: "--sample must be positive"},
+		{name: "sample with state", ops: threaddumpCommandOperations{sample: 10, interval: time.Second, stateFilter: "RUNNABLE"}, errContains: "--sample cannot be used with --summary, --analyze, --group or --state"},
+		{name: "sample with group", ops: threaddumpCommandOperations{sample: 10, interval: time.Second, group: true}, errContains: "--sample cannot be used with"},
+		{name: "zero interval", ops: threaddumpCommandOperations{sample: 10, outputDir: "."}, errContains: "--interval must be positive"},
+		{name: "output dir is a file", ops: threaddumpCommandOperations{sample: 10, interval: time.Second, outputDir: file}, errContains: "is not a directory"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := tt.ops
+			ops.pods = []string{"pod-1"}
+
+			err := ops.validate()
+			if tt.errContains == "" {
+				if err != nil {
+					t.Errorf("validate() unexpected error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("validate() error = %v, want error containing %q", err, tt.errContains)
+			}
+		})
+	}
+}
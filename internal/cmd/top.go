@@ -0,0 +1,408 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+const defaultTopInterval = 5 * time.Second
+
+// topEndpointURISuffixes match the uri tag of the requests that top itself sends on every refresh, which are
+// not counted as requests of the application
+var topEndpointURISuffixes = []string{"/metrics/{requiredMetricName}", "/threaddump"}
+
+const (
+	topSortByName     = "name"
+	topSortByCPU      = "cpu"
+	topSortByHeap     = "heap"
+	topSortByNonHeap  = "non-heap"
+	topSortByGC       = "gc"
+	topSortByThreads  = "threads"
+	topSortByBlocked  = "blocked"
+	topSortByRequests = "requests"
+	topSortByHikari   = "hikari"
+)
+
+var validTopSortColumns = []string{
+	topSortByName, topSortByCPU, topSortByHeap, topSortByNonHeap, topSortByGC,
+	topSortByThreads, topSortByBlocked, topSortByRequests, topSortByHikari,
+}
+
+type topCommandOperations struct {
+	baseOperations
+	sortBy   string
+	interval time.Duration
+	once     bool
+}
+
+func NewTopCommand(configFlags *genericclioptions.ConfigFlags, podResolver PodResolver) *cobra.Command {
+	operations := &topCommandOperations{
+		baseOperations: baseOperations{
+			k8sCliFlags: configFlags,
+			podResolver: podResolver,
+		},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "top",
+		Short: "Show JVM resource usage of the selected pods",
+		Long: `Show a refreshing overview of the JVM resource usage of the selected pods.
+
+Combines the standard Micrometer metrics with one row per pod:
+
+  CPU       process CPU usage (process.cpu.usage)
+  HEAP      used heap memory and its share of the maximum (jvm.memory.used/max)
+  NON-HEAP  used non-heap memory
+  GC PAUSE  total time spent in GC pauses (jvm.gc.pause)
+  THREADS   live threads by state, from the thread dump
+  REQ/S     HTTP requests per second since the previous refresh (http.server.requests),
+            without the requests of top itself. Other actuator requests, like the
+            health probes of Kubernetes, are included.
+  HIKARI    active and maximum connections of the Hikari pools
+
+Metrics that an application does not provide are shown as "-". Use
+--sort-by to order the pods by a column, with the highest values first.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := operations.complete(cmd); err != nil {
+				return err
+			}
+			if err := operations.validate(); err != nil {
+				return err
+			}
+			return operations.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVar(&operations.sortBy, "sort-by", topSortByName, "Sort pods by: "+strings.Join(validTopSortColumns, ", "))
+	cmd.Flags().DurationVar(&operations.interval, "interval", defaultTopInterval, "Refresh interval")
+	cmd.Flags().BoolVar(&operations.once, "once", false, "Show the overview once instead of refreshing it")
+
+	_ = cmd.RegisterFlagCompletionFunc("sort-by", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return validTopSortColumns, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	return cmd
+}
+
+func (o *topCommandOperations) validate() error {
+	if err := o.validatePods(); err != nil {
+		return err
+	}
+
+	if !slices.Contains(validTopSortColumns, o.sortBy) {
+		return fmt.Errorf("invalid sort field '%s'. Must be one of: %s", o.sortBy, strings.Join(validTopSortColumns, ", "))
+	}
+
+	if o.interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+
+	return nil
+}
+
+// run collects the stats of every pod until the context is cancelled and redraws the overview after each refresh
+func (o *topCommandOperations) run(ctx context.Context) error {
+	clients := make(map[string]actuator.Client, len(o.pods))
+	var pods []string
+	for _, pod := range o.pods {
+		client, err := o.actuatorClientFactory.NewClient(ctx, pod)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Error: %s: %v\n", pod, err)
+			continue
+		}
+		clients[pod] = client
+		pods = append(pods, pod)
+	}
+	if len(clients) == 0 {
+		return fmt.Errorf("top failed on %d pod(s)", len(o.pods))
+	}
+
+	previous := make(map[string]*podStats)
+	redraw := !o.once && isTerminal(os.Stdout)
+
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	for first := true; ; first = false {
+		current := make(map[string]*podStats, len(pods))
+		for _, pod := range pods {
+			current[pod] = collectPodStats(clients[pod], time.Now())
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if redraw {
+			fmt.Print(clearScreen)
+		} else if !first {
+			fmt.Println()
+		}
+		rows := buildTopRows(pods, current, previous)
+		sortTopRows(rows, o.sortBy)
+		displayTopRows(rows)
+
+		if o.once {
+			return nil
+		}
+
+		// Keep the last successful stats of a pod as the baseline for its request rate
+		for pod, stats := range current {
+			if stats.err == nil {
+				previous[pod] = stats
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// podStats holds the resource usage of a pod at one point in time. Values the application does not
+// provide are NaN.
+type podStats struct {
+	time         time.Time
+	cpu          float64
+	heapUsed     float64
+	heapMax      float64
+	nonHeapUsed  float64
+	gcPause      float64
+	requests     float64
+	hikariActive float64
+	hikariMax    float64
+	// threadStates counts the threads per state; nil if the thread dump is not available
+	threadStates map[string]int
+	err          error
+}
+
+func collectPodStats(client actuator.Client, now time.Time) *podStats {
+	stats := &podStats{time: now}
+
+	// The heap usage is provided by every JVM, so a failure here means the pod cannot be queried at all
+	heap, err := client.GetMetric("jvm.memory.used", "area:heap")
+	if err != nil {
+		stats.err = err
+		return stats
+	}
+	stats.heapUsed = measurementOrNaN(heap, "VALUE")
+
+	stats.heapMax = metricValue(client, "jvm.memory.max", "VALUE", "area:heap")
+	stats.nonHeapUsed = metricValue(client, "jvm.memory.used", "VALUE", "area:nonheap")
+	stats.cpu = metricValue(client, "process.cpu.usage", "VALUE")
+	stats.gcPause = metricValue(client, "jvm.gc.pause", statisticTotalTime)
+	stats.requests = applicationRequests(client)
+	stats.hikariActive = metricValue(client, "hikaricp.connections.active", "VALUE")
+	stats.hikariMax = metricValue(client, "hikaricp.connections.max", "VALUE")
+
+	if threadDump, err := client.GetThreadDump(); err == nil {
+		stats.threadStates = make(map[string]int)
+		for _, thread := range threadDump.Threads {
+			stats.threadStates[thread.ThreadState]++
+		}
+	}
+
+	return stats
+}
+
+// metricValue returns a statistic of a metric, or NaN if the metric is not available
+func metricValue(client actuator.Client, metricName string, statistic string, tags ...string) float64 {
+	metric, err := client.GetMetric(metricName, tags...)
+	if err != nil {
+		return math.NaN()
+	}
+	return measurementOrNaN(metric, statistic)
+}
+
+// applicationRequests returns the number of HTTP requests the application handled, without the requests
+// to the endpoints top queries itself, or NaN if the application does not record HTTP requests
+func applicationRequests(client actuator.Client) float64 {
+	metric, err := client.GetMetric("http.server.requests")
+	if err != nil {
+		return math.NaN()
+	}
+
+	count := measurementOrNaN(metric, statisticCount)
+	for _, tag := range metric.AvailableTags {
+		if tag.Tag != "uri" {
+			continue
+		}
+		for _, uri := range tag.Values {
+			if !slices.ContainsFunc(topEndpointURISuffixes, func(suffix string) bool { return strings.HasSuffix(uri, suffix) }) {
+				continue
+			}
+			if own := metricValue(client, "http.server.requests", statisticCount, "uri:"+uri); !math.IsNaN(own) {
+				count -= own
+			}
+		}
+	}
+	return count
+}
+
+func measurementOrNaN(metric *actuator.MetricResponse, statistic string) float64 {
+	if value, ok := measurement(metric, statistic); ok {
+		return value
+	}
+	return math.NaN()
+}
+
+type topRow struct {
+	pod   string
+	stats *podStats
+	// requestRate is the number of HTTP requests per second since the previous refresh, or NaN
+	requestRate float64
+}
+
+func buildTopRows(pods []string, current, previous map[string]*podStats) []topRow {
+	rows := make([]topRow, 0, len(pods))
+	for _, pod := range pods {
+		row := topRow{pod: pod, stats: current[pod], requestRate: math.NaN()}
+		if before := previous[pod]; before != nil && row.stats.err == nil {
+			elapsed := row.stats.time.Sub(before.time).Seconds()
+			delta := row.stats.requests - before.requests
+			// A negative delta means the counter was reset, e.g. because the application restarted
+			if elapsed > 0 && delta >= 0 {
+				row.requestRate = delta / elapsed
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func (r topRow) threads() float64 {
+	if r.stats.threadStates == nil {
+		return math.NaN()
+	}
+	total := 0
+	for _, count := range r.stats.threadStates {
+		total += count
+	}
+	return float64(total)
+}
+
+func (r topRow) threadsInState(states ...string) float64 {
+	if r.stats.threadStates == nil {
+		return math.NaN()
+	}
+	total := 0
+	for _, state := range states {
+		total += r.stats.threadStates[state]
+	}
+	return float64(total)
+}
+
+func (r topRow) sortValue(column string) float64 {
+	switch column {
+	case topSortByCPU:
+		return r.stats.cpu
+	case topSortByHeap:
+		return r.stats.heapUsed
+	case topSortByNonHeap:
+		return r.stats.nonHeapUsed
+	case topSortByGC:
+		return r.stats.gcPause
+	case topSortByThreads:
+		return r.threads()
+	case topSortByBlocked:
+		return r.threadsInState("BLOCKED")
+	case topSortByRequests:
+		return r.requestRate
+	case topSortByHikari:
+		return r.stats.hikariActive
+	}
+	return math.NaN()
+}
+
+// sortTopRows orders the rows by a column with the highest values first. Pods without a value come last.
+func sortTopRows(rows []topRow, column string) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		if column != topSortByName {
+			a, b := rows[i].sortValue(column), rows[j].sortValue(column)
+			if math.IsNaN(a) != math.IsNaN(b) {
+				return math.IsNaN(b)
+			}
+			if a != b && !math.IsNaN(a) {
+				return a > b
+			}
+		}
+		return rows[i].pod < rows[j].pod
+	})
+}
+
+func displayTopRows(rows []topRow) {
+	w := newTableWriter()
+
+	_, _ = fmt.Fprintln(w, "POD\tCPU\tHEAP\tHEAP%\tNON-HEAP\tGC PAUSE\tTHREADS\tRUNNABLE\tBLOCKED\tWAITING\tREQ/S\tHIKARI")
+	for _, row := range rows {
+		stats := row.stats
+		if stats.err != nil {
+			_, _ = fmt.Fprintf(w, "%s%s\n", row.pod, strings.Repeat("\t-", 11))
+			continue
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			row.pod,
+			formatTopValue(stats.cpu, func(v float64) string { return fmt.Sprintf("%.1f%%", v*100) }),
+			formatTopValue(stats.heapUsed, formatBytesHuman),
+			formatHeapPercentage(stats.heapUsed, stats.heapMax),
+			formatTopValue(stats.nonHeapUsed, formatBytesHuman),
+			formatTopValue(stats.gcPause, formatSecondsHuman),
+			formatTopValue(row.threads(), formatCount),
+			formatTopValue(row.threadsInState("RUNNABLE"), formatCount),
+			formatTopValue(row.threadsInState("BLOCKED"), formatCount),
+			formatTopValue(row.threadsInState("WAITING", "TIMED_WAITING"), formatCount),
+			formatTopValue(row.requestRate, func(v float64) string { return fmt.Sprintf("%.2f", v) }),
+			formatHikari(stats.hikariActive, stats.hikariMax),
+		)
+	}
+	_ = w.Flush()
+
+	for _, row := range rows {
+		if row.stats.err != nil {
+			fmt.Printf("Error: %s: %v\n", row.pod, row.stats.err)
+		}
+	}
+}
+
+func formatTopValue(value float64, format func(float64) string) string {
+	if math.IsNaN(value) {
+		return "-"
+	}
+	return format(value)
+}
+
+func formatCount(value float64) string {
+	return fmt.Sprintf("%.0f", value)
+}
+
+// formatHeapPercentage returns the used share of the maximum heap. The maximum is undefined (-1)
+// for memory pools without a limit.
+func formatHeapPercentage(used, max float64) string {
+	if math.IsNaN(used) || math.IsNaN(max) || max <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", used/max*100)
+}
+
+func formatHikari(active, max float64) string {
+	if math.IsNaN(active) {
+		return "-"
+	}
+	if math.IsNaN(max) {
+		return formatCount(active)
+	}
+	return formatCount(active) + "/" + formatCount(max)
+}
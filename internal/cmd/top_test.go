@@ -0,0 +1,279 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deviceinsight/kubectl-actuator/internal/actuator"
+)
+
+type fakeTopClient struct {
+	*fakeMetricsClient
+	threadDump *actuator.ThreadDumpResponse
+}
+
+func (f *fakeTopClient) GetThreadDump() (*actuator.ThreadDumpResponse, error) {
+	if f.threadDump == nil {
+		return nil, fmt.Errorf("failed to get thread dump: 404")
+	}
+	return f.threadDump, nil
+}
+
+func testValueMetric(name string, value float64) *actuator.MetricResponse {
+	return &actuator.MetricResponse{Name: name, Measurements: []actuator.Measurement{{Statistic: "VALUE", Value: value}}}
+}
+
+func testThreadDump(states ...string) *actuator.ThreadDumpResponse {
+	threads := make([]actuator.Thread, len(states))
+	for i, state := range states {
+		threads[i] = actuator.Thread{ThreadName: fmt.Sprintf("thread-%d", i), ThreadState: state}
+	}
+	return &actuator.ThreadDumpResponse{Threads: threads}
+}
+
+func newFakeTopClient(requests float64) *fakeTopClient {
+	return &fakeTopClient{
+		fakeMetricsClient: &fakeMetricsClient{metrics: map[string]*actuator.MetricResponse{
+			"jvm.memory.used area:heap":    testValueMetric("jvm.memory.used", 256*1024*1024),
+			"jvm.memory.max area:heap":     testValueMetric("jvm.memory.max", 1024*1024*1024),
+			"jvm.memory.used area:nonheap": testValueMetric("jvm.memory.used", 128*1024*1024),
+			"process.cpu.usage":            testValueMetric("process.cpu.usage", 0.125),
+			"jvm.gc.pause":                 testRequestsMetric(10, 0.25, 0.05),
+			"http.server.requests":         testRequestsMetric(requests, 10, 0.5),
+			"hikaricp.connections.active":  testValueMetric("hikaricp.connections.active", 3),
+			"hikaricp.connections.max":     testValueMetric("hikaricp.connections.max", 10),
+		}},
+		threadDump: testThreadDump("RUNNABLE", "RUNNABLE", "BLOCKED", "WAITING", "TIMED_WAITING", "TIMED_WAITING"),
+	}
+}
+
+func TestTopValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		pods        []string
+		sortBy      string
+		interval    time.Duration
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "defaults",
+			pods:     []string{"pod-1"},
+			sortBy:   topSortByName,
+			interval: time.Second,
+			wantErr:  false,
+		},
+		{
+			name:     "sort by cpu",
+			pods:     []string{"pod-1", "pod-2"},
+			sortBy:   topSortByCPU,
+			interval: time.Second,
+			wantErr:  false,
+		},
+		{
+			name:        "invalid sort field",
+			pods:        []string{"pod-1"},
+			sortBy:      "memory",
+			interval:    time.Second,
+			wantErr:     true,
+			errContains: "invalid sort field 'memory'",
+		},
+		{
+			name:        "zero interval",
+			pods:        []string{"pod-1"},
+			sortBy:      topSortByName,
+			interval:    0,
+			wantErr:     true,
+			errContains: "--interval must be positive",
+		},
+		{
+			name:        "no pods",
+			pods:        []string{},
+			sortBy:      topSortByName,
+			interval:    time.Second,
+			wantErr:     true,
+			errContains: "no pods selected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops := &topCommandOperations{
+				baseOperations: baseOperations{pods: tt.pods},
+				sortBy:         tt.sortBy,
+				interval:       tt.interval,
+			}
+
+			err := ops.validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && tt.errContains != "" && !strings.Contains(err.Error(), tt.errContains) {
+				t.Errorf("validate() error = %v, want error containing %q", err, tt.errContains)
+			}
+		})
+	}
+}
+
+func TestCollectPodStats(t *testing.T) {
+	start := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	client := newFakeTopClient(100)
+
+	stats := collectPodStats(client, start)
+
+	if stats.err != nil {
+		t.Fatalf("unexpected error: %v", stats.err)
+	}
+	if stats.cpu != 0.125 || stats.heapUsed != 256*1024*1024 || stats.heapMax != 1024*1024*1024 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+	if stats.gcPause != 0.25 || stats.requests != 100 || stats.hikariActive != 3 || stats.hikariMax != 10 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+	if stats.threadStates["TIMED_WAITING"] != 2 || stats.threadStates["BLOCKED"] != 1 {
+		t.Errorf("unexpected thread states %v", stats.threadStates)
+	}
+}
+
+func TestCollectPodStatsWithoutOwnRequests(t *testing.T) {
+	client := newFakeTopClient(100)
+	client.metrics["http.server.requests"] = testRequestsMetric(100, 10, 0.5, actuator.AvailableTag{
+		Tag:    "uri",
+		Values: []string{"/api/orders", "/actuator/health", "/actuator/metrics/{requiredMetricName}", "/actuator/threaddump"},
+	})
+	client.metrics["http.server.requests uri:/actuator/metrics/{requiredMetricName}"] = testRequestsMetric(45, 1, 0.1)
+	client.metrics["http.server.requests uri:/actuator/threaddump"] = testRequestsMetric(5, 1, 0.1)
+
+	stats := collectPodStats(client, time.Now())
+
+	if stats.requests != 50 {
+		t.Errorf("expected 50 requests without the ones of top, got %v", stats.requests)
+	}
+	for _, request := range client.requests {
+		if strings.Contains(request, "/api/orders") || strings.Contains(request, "/actuator/health") {
+			t.Errorf("unexpected request %q", request)
+		}
+	}
+}
+
+func TestCollectPodStatsMissingMetrics(t *testing.T) {
+	client := &fakeTopClient{fakeMetricsClient: &fakeMetricsClient{metrics: map[string]*actuator.MetricResponse{
+		"jvm.memory.used area:heap": testValueMetric("jvm.memory.used", 1024),
+	}}}
+
+	stats := collectPodStats(client, time.Now())
+
+	if stats.err != nil {
+		t.Fatalf("unexpected error: %v", stats.err)
+	}
+	if !math.IsNaN(stats.cpu) || !math.IsNaN(stats.requests) || !math.IsNaN(stats.hikariActive) {
+		t.Errorf("expected missing metrics to be NaN, got %+v", stats)
+	}
+	if stats.threadStates != nil {
+		t.Errorf("expected no thread states, got %v", stats.threadStates)
+	}
+
+	unreachable := &fakeTopClient{fakeMetricsClient: &fakeMetricsClient{}}
+	if stats := collectPodStats(unreachable, time.Now()); stats.err == nil {
+		t.Error("expected an error if the heap usage is not available")
+	}
+}
+
+func TestDisplayTopRows(t *testing.T) {
+	start := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	busy := newFakeTopClient(100)
+	busy.metrics["process.cpu.usage"] = testValueMetric("process.cpu.usage", 0.8)
+	idle := newFakeTopClient(100)
+	idle.threadDump = nil
+	delete(idle.metrics, "hikaricp.connections.active")
+
+	pods := []string{"pod-a", "pod-b", "pod-c"}
+	previous := map[string]*podStats{
+		"pod-a": collectPodStats(idle, start),
+		"pod-b": collectPodStats(busy, start),
+	}
+
+	// pod-b handled 50 requests within 10s
+	busy.metrics["http.server.requests"] = testRequestsMetric(150, 20, 0.5)
+	current := map[string]*podStats{
+		"pod-a": collectPodStats(idle, start.Add(10*time.Second)),
+		"pod-b": collectPodStats(busy, start.Add(10*time.Second)),
+		"pod-c": collectPodStats(&fakeTopClient{fakeMetricsClient: &fakeMetricsClient{}}, start.Add(10*time.Second)),
+	}
+
+	rows := buildTopRows(pods, current, previous)
+	sortTopRows(rows, topSortByCPU)
+
+	output := captureOutput(func() {
+		displayTopRows(rows)
+	})
+
+	expectedRegex := []string{
+		`POD\s+CPU\s+HEAP\s+HEAP%\s+NON-HEAP\s+GC PAUSE\s+THREADS\s+RUNNABLE\s+BLOCKED\s+WAITING\s+REQ/S\s+HIKARI`,
+		`pod-b\s+80\.0%\s+256\.0 MB\s+25%\s+128\.0 MB\s+250\.00 ms\s+6\s+2\s+1\s+3\s+5\.00\s+3/10`,
+		`pod-a\s+12\.5%\s+256\.0 MB\s+25%\s+128\.0 MB\s+250\.00 ms\s+-\s+-\s+-\s+-\s+0\.00\s+-`,
+		`pod-c(\s+-){11}`,
+		`(?s)pod-b.*pod-a.*pod-c`,
+		`Error: pod-c: metric 'jvm.memory.used area:heap' not found`,
+	}
+	for _, pattern := range expectedRegex {
+		if !regexp.MustCompile(pattern).MatchString(output) {
+			t.Errorf("expected output to match %q, got:\n%s", pattern, output)
+		}
+	}
+}
+
+func TestSortTopRows(t *testing.T) {
+	rows := []topRow{
+		{pod: "pod-c", stats: &podStats{cpu: 0.2}, requestRate: math.NaN()},
+		{pod: "pod-a", stats: &podStats{cpu: math.NaN()}, requestRate: 5},
+		{pod: "pod-b", stats: &podStats{cpu: 0.5}, requestRate: 1},
+	}
+
+	tests := []struct {
+		column string
+		want   []string
+	}{
+		{column: topSortByName, want: []string{"pod-a", "pod-b", "pod-c"}},
+		{column: topSortByCPU, want: []string{"pod-b", "pod-c", "pod-a"}},
+		{column: topSortByRequests, want: []string{"pod-a", "pod-b", "pod-c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.column, func(t *testing.T) {
+			sorted := append([]topRow(nil), rows...)
+			sortTopRows(sorted, tt.column)
+
+			var got []string
+			for _, row := range sorted {
+				got = append(got, row.pod)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatHeapPercentage(t *testing.T) {
+	tests := []struct {
+		used, max float64
+		want      string
+	}{
+		{used: 256, max: 1024, want: "25%"},
+		{used: 256, max: -1, want: "-"},
+		{used: 256, max: math.NaN(), want: "-"},
+	}
+
+	for _, tt := range tests {
+		if got := formatHeapPercentage(tt.used, tt.max); got != tt.want {
+			t.Errorf("formatHeapPercentage(%v, %v) = %q, want %q", tt.used, tt.max, got, tt.want)
+		}
+	}
+}
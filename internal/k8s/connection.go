@@ -8,6 +8,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -88,18 +89,13 @@ func (c *Connection) ListDeployments(ctx context.Context, namespace string) ([]s
 }
 
 func (c *Connection) GetDeploymentPods(ctx context.Context, namespace, deploymentName string) ([]string, error) {
-	deployment, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metav1.GetOptions{})
-	if err != nil {
-		return nil, err
-	}
-
-	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	selector, err := c.deploymentSelector(ctx, namespace, deploymentName)
 	if err != nil {
 		return nil, err
 	}
 
 	podList, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
-		LabelSelector: selector.String(),
+		LabelSelector: selector,
 	})
 	if err != nil {
 		return nil, err
@@ -113,6 +109,115 @@ func (c *Connection) GetDeploymentPods(ctx context.Context, namespace, deploymen
 	return podNames, nil
 }
 
+// GetPodDeployment returns the name of the Deployment that owns a pod through its ReplicaSet
+func (c *Connection) GetPodDeployment(ctx context.Context, namespace, podName string) (string, error) {
+	pod, err := c.clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != "ReplicaSet" {
+		return "", fmt.Errorf("pod %s is not managed by a Deployment", podName)
+	}
+
+	replicaSet, err := c.clientset.AppsV1().ReplicaSets(namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	owner = metav1.GetControllerOf(replicaSet)
+	if owner == nil || owner.Kind != "Deployment" {
+		return "", fmt.Errorf("pod %s is not managed by a Deployment", podName)
+	}
+
+	return owner.Name, nil
+}
+
+// WaitForReplacementPod watches the pods of a deployment until the given pod has been replaced by a ready pod
+// and returns the name of that pod. A pod is replaced either by the pod itself once its containers were
+// restarted, or by a pod that is not in existingPods.
+func (c *Connection) WaitForReplacementPod(ctx context.Context, namespace, deploymentName string, replaced *corev1.Pod, existingPods []string) (string, error) {
+	selector, err := c.deploymentSelector(ctx, namespace, deploymentName)
+	if err != nil {
+		return "", err
+	}
+
+	watcher, err := c.clientset.CoreV1().Pods(namespace).Watch(ctx, metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer watcher.Stop()
+
+	existing := make(map[string]struct{}, len(existingPods))
+	for _, name := range existingPods {
+		existing[name] = struct{}{}
+	}
+	restarts := containerRestarts(replaced)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return "", fmt.Errorf("watch of deployment %s pods closed unexpectedly", deploymentName)
+			}
+			if event.Type == watch.Error {
+				return "", fmt.Errorf("watch of deployment %s pods failed: %v", deploymentName, event.Object)
+			}
+
+			pod, ok := event.Object.(*corev1.Pod)
+			if !ok || event.Type == watch.Deleted || pod.DeletionTimestamp != nil || !isPodReady(pod) {
+				continue
+			}
+
+			if pod.UID == replaced.UID {
+				if containerRestarts(pod) > restarts {
+					return pod.Name, nil
+				}
+				continue
+			}
+			if _, ok := existing[pod.Name]; !ok {
+				return pod.Name, nil
+			}
+		}
+	}
+}
+
+func (c *Connection) deploymentSelector(ctx context.Context, namespace, deploymentName string) (string, error) {
+	deployment, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return "", err
+	}
+
+	return selector.String(), nil
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func containerRestarts(pod *corev1.Pod) int32 {
+	var restarts int32
+	for _, status := range pod.Status.ContainerStatuses {
+		restarts += status.RestartCount
+	}
+	return restarts
+}
+
 func (c *Connection) Clientset() kubernetes.Interface {
 	return c.clientset
 }
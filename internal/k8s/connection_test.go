@@ -3,12 +3,16 @@ package k8s
 import (
 	"context"
 	"testing"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func TestGetPod(t *testing.T) {
@@ -606,3 +610,212 @@ func TestNamespaceAccessor(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPodDeployment(t *testing.T) {
+	controller := true
+	replicaSet := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-app-7d9f8c",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "Deployment", Name: "my-app", Controller: &controller},
+			},
+		},
+	}
+	orphanReplicaSet := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "standalone-rs",
+			Namespace: "default",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		pod            *corev1.Pod
+		wantDeployment string
+		wantErr        bool
+	}{
+		{
+			name: "pod owned by deployment",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-app-7d9f8c-abc12",
+					Namespace: "default",
+					OwnerReferences: []metav1.OwnerReference{
+						{Kind: "ReplicaSet", Name: "my-app-7d9f8c", Controller: &controller},
+					},
+				},
+			},
+			wantDeployment: "my-app",
+		},
+		{
+			name: "bare pod",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "bare-pod", Namespace: "default"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "pod owned by replica set without deployment",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "standalone-rs-xyz",
+					Namespace: "default",
+					OwnerReferences: []metav1.OwnerReference{
+						{Kind: "ReplicaSet", Name: "standalone-rs", Controller: &controller},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "pod owned by stateful set",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "db-0",
+					Namespace: "default",
+					OwnerReferences: []metav1.OwnerReference{
+						{Kind: "StatefulSet", Name: "db", Controller: &controller},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientset := fake.NewClientset(tt.pod, replicaSet, orphanReplicaSet)
+			conn := &Connection{clientset: clientset, namespace: "default"}
+
+			deployment, err := conn.GetPodDeployment(context.Background(), "default", tt.pod.Name)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetPodDeployment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if deployment != tt.wantDeployment {
+				t.Errorf("GetPodDeployment() = %q, want %q", deployment, tt.wantDeployment)
+			}
+		})
+	}
+}
+
+func TestWaitForReplacementPod(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "my-app"}},
+		},
+	}
+
+	newPod := func(name string, uid string, ready bool, restarts int32) *corev1.Pod {
+		status := corev1.ConditionFalse
+		if ready {
+			status = corev1.ConditionTrue
+		}
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				UID:       types.UID(uid),
+				Labels:    map[string]string{"app": "my-app"},
+			},
+			Status: corev1.PodStatus{
+				Conditions:        []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "app", RestartCount: restarts}},
+			},
+		}
+	}
+
+	replaced := newPod("my-app-abc12", "uid-1", true, 0)
+
+	tests := []struct {
+		name     string
+		events   []watch.Event
+		wantPod  string
+		wantErr  bool
+		existing []string
+	}{
+		{
+			name: "new pod becomes ready",
+			events: []watch.Event{
+				{Type: watch.Added, Object: newPod("my-app-def34", "uid-2", true, 0)},
+				{Type: watch.Added, Object: newPod("my-app-ghi56", "uid-3", false, 0)},
+				{Type: watch.Modified, Object: newPod("my-app-ghi56", "uid-3", true, 0)},
+			},
+			existing: []string{"my-app-abc12", "my-app-def34"},
+			wantPod:  "my-app-ghi56",
+		},
+		{
+			name: "pod becomes ready again after restart",
+			events: []watch.Event{
+				{Type: watch.Modified, Object: newPod("my-app-abc12", "uid-1", true, 0)},
+				{Type: watch.Modified, Object: newPod("my-app-abc12", "uid-1", false, 1)},
+				{Type: watch.Modified, Object: newPod("my-app-abc12", "uid-1", true, 1)},
+			},
+			existing: []string{"my-app-abc12"},
+			wantPod:  "my-app-abc12",
+		},
+		{
+			name: "deleted pods are ignored",
+			events: []watch.Event{
+				{Type: watch.Deleted, Object: newPod("my-app-jkl78", "uid-4", true, 0)},
+				{Type: watch.Added, Object: newPod("my-app-mno90", "uid-5", true, 0)},
+			},
+			existing: []string{"my-app-abc12"},
+			wantPod:  "my-app-mno90",
+		},
+		{
+			name: "watch error",
+			events: []watch.Event{
+				{Type: watch.Error, Object: &metav1.Status{Message: "too old resource version"}},
+			},
+			existing: []string{"my-app-abc12"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientset := fake.NewClientset(deployment)
+			watcher := watch.NewFake()
+			clientset.PrependWatchReactor("pods", k8stesting.DefaultWatchReactor(watcher, nil))
+			conn := &Connection{clientset: clientset, namespace: "default"}
+
+			go func() {
+				for _, event := range tt.events {
+					watcher.Action(event.Type, event.Object)
+				}
+			}()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			podName, err := conn.WaitForReplacementPod(ctx, "default", "my-app", replaced, tt.existing)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WaitForReplacementPod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if podName != tt.wantPod {
+				t.Errorf("WaitForReplacementPod() = %q, want %q", podName, tt.wantPod)
+			}
+		})
+	}
+
+	t.Run("context cancelled", func(t *testing.T) {
+		clientset := fake.NewClientset(deployment)
+		clientset.PrependWatchReactor("pods", k8stesting.DefaultWatchReactor(watch.NewFake(), nil))
+		conn := &Connection{clientset: clientset, namespace: "default"}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		if _, err := conn.WaitForReplacementPod(ctx, "default", "my-app", replaced, nil); err == nil {
+			t.Error("expected error when the context is cancelled")
+		}
+	})
+}
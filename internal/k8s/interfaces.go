@@ -13,6 +13,8 @@ type Client interface {
 	ListPods(ctx context.Context, namespace, labelSelector string) ([]string, error)
 	ListDeployments(ctx context.Context, namespace string) ([]string, error)
 	GetDeploymentPods(ctx context.Context, namespace, deploymentName string) ([]string, error)
+	GetPodDeployment(ctx context.Context, namespace, podName string) (string, error)
+	WaitForReplacementPod(ctx context.Context, namespace, deploymentName string, replaced *corev1.Pod, existingPods []string) (string, error)
 	Clientset() kubernetes.Interface
 	Namespace() string
 }
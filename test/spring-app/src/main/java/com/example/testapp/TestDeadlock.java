@@ -0,0 +1,43 @@
+package com.example.testapp;
+
+import jakarta.annotation.PostConstruct;
+import java.util.concurrent.CountDownLatch;
+import org.springframework.stereotype.Component;
+
+/**
+ * Deadlocks two daemon threads on startup so that the thread dump analysis can be tested.
+ */
+@Component
+public class TestDeadlock {
+
+    private final Object first = new Object();
+    private final Object second = new Object();
+    private final CountDownLatch bothLocked = new CountDownLatch(2);
+
+    @PostConstruct
+    public void start() {
+        startThread("test-deadlock-1", first, second);
+        startThread("test-deadlock-2", second, first);
+    }
+
+    private void startThread(String name, Object owned, Object wanted) {
+        Thread thread = new Thread(() -> lockBoth(owned, wanted), name);
+        thread.setDaemon(true);
+        thread.start();
+    }
+
+    private void lockBoth(Object owned, Object wanted) {
+        synchronized (owned) {
+            bothLocked.countDown();
+            try {
+                bothLocked.await();
+            } catch (InterruptedException e) {
+                Thread.currentThread().interrupt();
+                return;
+            }
+            synchronized (wanted) {
+                // never reached
+            }
+        }
+    }
+}
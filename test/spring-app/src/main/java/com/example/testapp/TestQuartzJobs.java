@@ -0,0 +1,59 @@
+package com.example.testapp;
+
+import java.time.Instant;
+import java.util.Date;
+
+import org.quartz.CronScheduleBuilder;
+import org.quartz.JobBuilder;
+import org.quartz.JobDetail;
+import org.quartz.JobExecutionContext;
+import org.quartz.SimpleScheduleBuilder;
+import org.quartz.Trigger;
+import org.quartz.TriggerBuilder;
+import org.slf4j.Logger;
+import org.slf4j.LoggerFactory;
+import org.springframework.context.annotation.Bean;
+import org.springframework.context.annotation.Configuration;
+import org.springframework.scheduling.quartz.QuartzJobBean;
+
+@Configuration
+public class TestQuartzJobs {
+
+    @Bean
+    public JobDetail reportJob() {
+        return JobBuilder.newJob(ReportJob.class)
+                .withIdentity("reportJob", "samples")
+                .withDescription("Generates the nightly report")
+                .storeDurably()
+                .build();
+    }
+
+    @Bean
+    public Trigger nightlyReportTrigger(JobDetail reportJob) {
+        return TriggerBuilder.newTrigger()
+                .forJob(reportJob)
+                .withIdentity("nightlyReport", "samples")
+                .withSchedule(CronScheduleBuilder.cronSchedule("0 0 3 * * ?"))
+                .build();
+    }
+
+    @Bean
+    public Trigger hourlyReportTrigger(JobDetail reportJob) {
+        return TriggerBuilder.newTrigger()
+                .forJob(reportJob)
+                .withIdentity("hourlyReport", "samples")
+                .startAt(Date.from(Instant.now().plusSeconds(3600)))
+                .withSchedule(SimpleScheduleBuilder.repeatHourlyForever())
+                .build();
+    }
+
+    public static class ReportJob extends QuartzJobBean {
+
+        private static final Logger logger = LoggerFactory.getLogger(ReportJob.class);
+
+        @Override
+        protected void executeInternal(JobExecutionContext context) {
+            logger.info("Executing report job");
+        }
+    }
+}
@@ -0,0 +1 @@
+ALTER TABLE orders ADD COLUMN status VARCHAR(32) DEFAULT 'NEW' NOT NULL;